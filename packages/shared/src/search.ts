@@ -4,6 +4,11 @@
  * These interfaces define the contract for ticker search functionality.
  */
 
+/**
+ * Asset type of a search result.
+ */
+export type SearchResultType = 'stock' | 'etf';
+
 /**
  * A single ticker search result.
  *
@@ -18,16 +23,17 @@ export interface TickerSearchResult {
   /** Stock exchange where the ticker is listed (e.g., "NASDAQ", "NYSE") */
   exchange: string;
   /** Asset type: "stock" or "etf" */
-  type?: string;
+  type?: SearchResultType;
   /** GICS sector classification, if available */
   sector?: string;
 }
 
 /**
- * Response for GET /api/search?q={query}.
+ * Response for GET /api/search?q={query}&limit={n}&types={stock,etf}.
  *
- * Returns matching tickers for the search query, ordered by relevance.
- * Results are limited (typically 10-20) for performance.
+ * Returns matching tickers deduplicated by symbol, ordered by relevance:
+ * exact ticker matches first, then ticker prefixes, then name matches.
+ * Results are limited (default 10) for performance.
  */
 export interface SearchResponse {
   /** Matching ticker results, ordered by relevance */
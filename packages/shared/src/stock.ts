@@ -22,12 +22,18 @@ export interface Company {
   exchange: string;
   /** GICS sector classification (e.g., "Technology", "Healthcare") */
   sector: string;
+  /** Sector reported by the provider when a configured override remapped `sector` */
+  providerSector?: string;
   /** GICS industry classification (e.g., "Software", "Semiconductors") */
   industry: string;
   /** Brief company description/business summary */
   description?: string;
   /** Company website URL */
   website?: string;
+  /** Reporting currency of the financial statements (ISO 4217, e.g., "USD", "GBP") */
+  currency?: string;
+  /** True when non-USD financials were converted to USD for ratio calculations */
+  normalized?: boolean;
 }
 
 // =============================================================================
@@ -61,6 +67,28 @@ export interface Quote {
 // Scoring Systems
 // =============================================================================
 
+/**
+ * A single Piotroski criterion with the values that were compared.
+ */
+export interface PiotroskiCriterion {
+  /** Criterion name (e.g., "Positive ROA", "CFO > Net Income") */
+  name: string;
+  /** Whether the criterion passed (contributes 1 point) */
+  passed: boolean;
+  /** Current-period value being tested */
+  currentValue: number | null;
+  /** Value compared against: prior-period value or threshold (e.g., 0) */
+  comparedValue: number | null;
+}
+
+/**
+ * Piotroski F-Score criteria set.
+ * - classic: Original nine criteria
+ * - bank: Financial Services variant that swaps gross margin, asset turnover
+ *   and current ratio for bank-relevant tests (efficiency ratio, loan-loss provisioning)
+ */
+export type PiotroskiModel = 'classic' | 'bank';
+
 /**
  * Piotroski F-Score breakdown.
  *
@@ -99,6 +127,10 @@ export interface PiotroskiScore {
     /** Asset turnover ratio increased YoY */
     higherAssetTurnover: boolean;
   };
+  /** All nine criteria in display order, with the underlying values compared */
+  criteria?: PiotroskiCriterion[];
+  /** Criteria set used; for the bank model, criteria carries the substituted tests */
+  model?: PiotroskiModel;
 }
 
 /**
@@ -115,23 +147,37 @@ export interface RuleOf40 {
   score: number;
   /** Year-over-year revenue growth as percentage */
   revenueGrowthPercent: number;
-  /** Profit margin (typically EBITDA or FCF margin) as percentage */
+  /** Profit margin as percentage (operating margin, or FCF margin for the FCF variant) */
   profitMarginPercent: number;
   /** Whether the company passes the Rule of 40 (score >= 40) */
   passed: boolean;
 }
 
+/**
+ * Altman Z-Score formula variant.
+ * - classic: Original manufacturing model (five components)
+ * - z-double-prime: Z''-Score for financial and non-manufacturing firms
+ */
+export type AltmanZModel = 'classic' | 'z-double-prime';
+
 /**
  * Altman Z-Score for bankruptcy prediction.
  *
  * A financial model that predicts the probability of bankruptcy.
- * Originally developed for manufacturing companies but widely applied.
+ * Originally developed for manufacturing companies; financial and
+ * non-manufacturing firms use the Z''-Score variant, which drops the
+ * sales/assets term.
  *
- * Zones:
+ * Zones (classic):
  * - Safe (Z > 2.99): Low bankruptcy risk
  * - Gray (1.81 < Z < 2.99): Uncertain, needs monitoring
  * - Distress (Z < 1.81): High bankruptcy risk
  *
+ * Zones (Z''):
+ * - Safe (Z > 2.60)
+ * - Gray (1.10 < Z < 2.60)
+ * - Distress (Z < 1.10)
+ *
  * @see https://www.investopedia.com/terms/a/altman.asp
  */
 export interface AltmanZScore {
@@ -139,6 +185,8 @@ export interface AltmanZScore {
   score: number;
   /** Risk classification based on score thresholds */
   zone: 'distress' | 'gray' | 'safe';
+  /** Formula used: classic manufacturing model or Z'' (zone thresholds differ) */
+  model?: AltmanZModel;
   /** Individual components of the Z-Score formula */
   components: {
     /** Working Capital / Total Assets (liquidity) */
@@ -149,7 +197,7 @@ export interface AltmanZScore {
     ebitToAssets: number;
     /** Market Cap / Total Liabilities (solvency) */
     marketCapToLiabilities: number;
-    /** Sales / Total Assets (asset efficiency) */
+    /** Sales / Total Assets (asset efficiency, not used by the Z'' model) */
     salesToAssets: number;
   };
 }
@@ -167,8 +215,33 @@ export interface DCFValuation {
   currentPrice: number;
   /** Percentage difference: ((intrinsicValue - currentPrice) / currentPrice) * 100 */
   differencePercent: number;
-  /** Valuation assessment based on difference threshold (±15%) */
+  /** Valuation assessment based on the fair value band (±15% by default) */
   assessment: 'Undervalued' | 'Fairly Valued' | 'Overvalued' | 'N/A';
+  /** Fair value band used for the assessment as percentage (e.g., 15 for ±15%) */
+  fairValueBand?: number;
+}
+
+/**
+ * Composite 0-100 quality score built from sector percentiles.
+ *
+ * Default weights: profitability (ROIC, ROE, margins) 40%, financial health
+ * (D/E, current ratio, interest coverage) 35%, earnings quality (accrual ratio) 25%.
+ */
+export interface QualityScore {
+  /** Weighted composite score (0-100, higher = better quality) */
+  score: number;
+  /** Average profitability percentile (0-100) */
+  profitability: number | null;
+  /** Average financial health percentile (0-100) */
+  financialHealth: number | null;
+  /** Earnings quality percentile (0-100) */
+  earningsQuality: number | null;
+  /** Weights applied to each component (sum to 1) */
+  weights: {
+    profitability: number;
+    financialHealth: number;
+    earningsQuality: number;
+  };
 }
 
 /**
@@ -179,10 +252,14 @@ export interface Scores {
   piotroski: PiotroskiScore;
   /** Rule of 40 (growth + profitability balance) */
   ruleOf40: RuleOf40;
+  /** Rule of 40 using free cash flow margin instead of operating margin */
+  ruleOf40Fcf?: RuleOf40;
   /** Altman Z-Score (financial health/bankruptcy risk) */
   altmanZ: AltmanZScore;
   /** DCF valuation analysis */
   dcfValuation: DCFValuation;
+  /** Composite quality score from sector percentiles */
+  qualityScore?: QualityScore;
 }
 
 // =============================================================================
@@ -207,6 +284,12 @@ export type SignalCategory =
   | 'valuation' // P/E, P/B, relative value metrics
   | 'technical'; // Price action, momentum indicators
 
+/**
+ * How far past its threshold the underlying metric is
+ * (e.g., ROIC at 40% is a stronger signal than at 16%).
+ */
+export type SignalStrength = 'weak' | 'moderate' | 'strong';
+
 /**
  * A discrete signal or flag surfaced to the user.
  *
@@ -222,6 +305,8 @@ export interface Signal {
   message: string;
   /** Importance ranking (1-5, higher = more important) */
   priority: number;
+  /** Signal strength; signals are returned strongest first */
+  strength?: SignalStrength;
   /** Optional supporting data points */
   data?: Record<string, number | string>;
 }
@@ -264,6 +349,11 @@ export interface Valuation {
   priceToBook: ValuationMetric;
   /** NTM (Next Twelve Months) Price-to-Sales based on analyst revenue estimates */
   ntmPs: ValuationMetric;
+  /**
+   * Blended discount to sector median across metrics as percentage
+   * (positive = discount, negative = premium; null if too few metrics have sector data)
+   */
+  sectorDiscount?: number | null;
 }
 
 // =============================================================================
@@ -324,6 +414,26 @@ export interface PeerValuation {
   peg: number | null;
   /** EPS growth rate as percentage (null if not available) */
   growth: number | null;
+  /** Enterprise Value to Sales ratio (null if not available) */
+  evToSales?: number | null;
+  /** Enterprise Value to Free Cash Flow ratio (null if not available) */
+  evToFcf?: number | null;
+  /** Enterprise Value to EBIT ratio (null if not available or EBIT is negative) */
+  evToEbit?: number | null;
+  /** Period end date of the TTM ratios (ISO 8601), to flag stale off-cycle peers */
+  ratiosAsOf?: string;
+  /** Fiscal year end month-day (e.g., "09-30") */
+  fiscalYearEnd?: string;
+}
+
+/**
+ * The stock's percentile rank among peers for a single valuation metric.
+ */
+export interface PeerMetricRank {
+  /** Metric key (e.g., "pe", "evToEbitda", "ps", "priceToFcf", "peg") */
+  key: string;
+  /** Percentile among peers (0-100, lower = cheaper) */
+  percentile: number;
 }
 
 /**
@@ -337,13 +447,16 @@ export interface SectorMedians {
   priceToFcf: number | null;
   peg: number | null;
   growth: number | null;
+  evToSales?: number | null;
+  evToFcf?: number | null;
+  evToEbit?: number | null;
 }
 
 /**
  * A single valuation metric row with comparison context.
  */
 export interface ValuationMetricRow {
-  /** Metric key identifier */
+  /** Metric key identifier (e.g., "pe", "evToEbitda", "evToEbit", "evToSales", "evToFcf") */
   key: string;
   /** Display label */
   label: string;
@@ -361,6 +474,153 @@ export interface ValuationMetricRow {
   lowerIsBetter: boolean;
 }
 
+/**
+ * Weighted average cost of capital breakdown used as the DCF discount rate.
+ *
+ * Cost of equity comes from CAPM (risk-free rate + beta × equity risk premium);
+ * cost of debt from interest expense / total debt. All rates are percentages.
+ */
+export interface WACCBreakdown {
+  /** Weighted average cost of capital */
+  wacc: number;
+  /** CAPM cost of equity */
+  costOfEquity: number;
+  /** Pre-tax cost of debt (interest expense / total debt) */
+  costOfDebt: number;
+  /** Effective tax rate applied to cost of debt */
+  taxRate: number;
+  /** Risk-free rate assumed */
+  riskFreeRate: number;
+  /** Equity risk premium assumed */
+  equityRiskPremium: number;
+  /** Company beta relative to the market */
+  beta: number;
+  /** Equity weight (market cap / (market cap + total debt)) */
+  equityWeight: number;
+  /** Debt weight (total debt / (market cap + total debt)) */
+  debtWeight: number;
+}
+
+/**
+ * One row of the reverse DCF sensitivity table.
+ */
+export interface ReverseDCFSensitivity {
+  /** Discount rate used for this row as percentage (e.g., 10 for 10%) */
+  discountRate: number;
+  /** FCF growth rate implied at this discount rate as percentage (null if unsolvable) */
+  impliedGrowthRate: number | null;
+}
+
+/**
+ * Reverse DCF analysis.
+ *
+ * Solves for the 5-year FCF growth rate that makes the DCF intrinsic value
+ * equal the current market price, i.e. the growth the market is pricing in.
+ */
+export interface ReverseDCF {
+  /** Solved 5-year FCF growth rate as percentage (null if no solution in search bounds) */
+  impliedGrowthRate: number | null;
+  /** Discount rate assumed as percentage */
+  discountRate: number;
+  /** Terminal growth rate assumed as percentage */
+  terminalGrowthRate: number;
+  /** Projection horizon in years */
+  projectionYears: number;
+  /** Trailing twelve month free cash flow in USD */
+  fcfTTM: number;
+  /** Net debt (total debt - cash) in USD */
+  netDebt: number;
+  /** Diluted shares outstanding */
+  sharesOutstanding: number;
+  /** Current market price per share */
+  currentPrice: number;
+  /** Implied growth at discount rates of -2%, base, and +2% */
+  sensitivity: ReverseDCFSensitivity[];
+}
+
+/**
+ * Graham Number intrinsic value floor: sqrt(22.5 × EPS × book value per share).
+ * Only computed when both diluted EPS and book value per share are positive.
+ */
+export interface GrahamNumber {
+  /** Graham Number per share */
+  value: number;
+  /** Diluted EPS used in the calculation */
+  eps: number;
+  /** Book value per share used in the calculation */
+  bookValuePerShare: number;
+  /** Current market price per share */
+  currentPrice: number;
+  /** Percentage difference: ((currentPrice - value) / value) * 100 */
+  premiumPercent: number;
+}
+
+/**
+ * Graham net-net screen: net current asset value (current assets minus
+ * total liabilities) per share compared against price.
+ */
+export interface NCAV {
+  /** NCAV per share (may be negative) */
+  ncavPerShare: number;
+  /** Current market price per share */
+  currentPrice: number;
+  /** Price as a fraction of NCAV per share (null when NCAV is not positive) */
+  priceToNcav: number | null;
+  /** True when price is below 2/3 of NCAV per share */
+  isNetNet: boolean;
+}
+
+/**
+ * Earnings Power Value: normalized EBIT × (1 − tax rate) / WACC, adjusted
+ * for net debt. Values the business assuming no growth.
+ */
+export interface EarningsPowerValue {
+  /** EPV per share */
+  epvPerShare: number;
+  /** Normalized (multi-year average) EBIT */
+  normalizedEbit: number;
+  /** Effective tax rate applied as percentage */
+  taxRate: number;
+  /** Discount rate (WACC) used as percentage */
+  discountRate: number;
+  /** Net debt subtracted from the enterprise EPV */
+  netDebt: number;
+  /** Current market price per share */
+  currentPrice: number;
+  /** Margin of safety percentage (positive = price below EPV) */
+  marginOfSafety: number;
+  /** Assessment using the same thresholds as the DCF analysis */
+  assessment: 'Undervalued' | 'Fairly Valued' | 'Overvalued' | 'N/A';
+}
+
+/**
+ * Independent DCF built from owner earnings rather than FMP's DCF model.
+ */
+export interface OwnerEarningsDCF {
+  /** Intrinsic value per share from discounted owner earnings */
+  intrinsicValue: number;
+  /** Owner earnings growth rate assumed as percentage */
+  growthRate: number;
+  /** Discount rate (WACC) used as percentage */
+  discountRate: number;
+  /** Projection horizon in years (5-15) */
+  horizonYears?: number;
+  /** Terminal growth rate as percentage (always below the discount rate) */
+  terminalGrowthRate?: number;
+  /** FMP DCF intrinsic value per share for comparison (null if unavailable) */
+  fmpIntrinsicValue: number | null;
+  /** ((intrinsicValue - fmpIntrinsicValue) / fmpIntrinsicValue) * 100 (null if no FMP value) */
+  divergencePercent: number | null;
+  /** True when the two models diverge enough to warrant caution */
+  divergent: boolean;
+}
+
+/**
+ * Confidence in the overall verdict, based on how many of the three
+ * sub-scores were computable and how closely they agree.
+ */
+export type VerdictConfidence = 'low' | 'medium' | 'high';
+
 /**
  * Full valuation deep dive response for GET /api/stock/{ticker}/valuation.
  *
@@ -409,6 +669,12 @@ export interface ValuationDeepDive {
     medians: SectorMedians | null;
     /** Auto-generated insight text */
     insight: string;
+    /** Per-metric peer ranks averaged into the sector score */
+    metricRanks?: PeerMetricRank[];
+    /** Basis of the sector score: blended across metrics, or P/E only when others are missing */
+    scoreBasis?: 'blended' | 'pe-only';
+    /** Number of peers whose ratios fed the medians and percentile (sample size) */
+    peerCount?: number;
   } | null;
 
   // Growth Justification (PEG-based)
@@ -428,6 +694,8 @@ export interface ValuationDeepDive {
   verdict: string;
   /** Overall sentiment derived from combined scores */
   sentiment: 'cheap' | 'fair' | 'expensive';
+  /** Confidence in the verdict (low when built from a single or conflicting signal) */
+  verdictConfidence?: VerdictConfidence;
 
   // Key Valuation Metrics with context
   /** Key valuation metrics with comparison context */
@@ -446,10 +714,28 @@ export interface ValuationDeepDive {
     marginOfSafety: number;
     /** Implied growth rate baked into current price */
     impliedGrowthRate: number | null;
+    /** Discount rate used as percentage (WACC when computable, otherwise 10%) */
+    discountRate?: number;
+    /** WACC components behind the discount rate (null if WACC not computable) */
+    wacc?: WACCBreakdown | null;
     /** Assessment: Undervalued, Fairly Valued, Overvalued */
     assessment: 'Undervalued' | 'Fairly Valued' | 'Overvalued' | 'N/A';
+    /** Fair value band used for the assessment as percentage (e.g., 15 for ±15%) */
+    fairValueBand?: number;
   } | null;
 
+  /** Reverse DCF solving for the growth rate implied by the current price */
+  reverseDcf?: ReverseDCF | null;
+
+  /** Graham Number (null when EPS or book value per share is negative) */
+  grahamNumber?: GrahamNumber | null;
+
+  /** Net current asset value screen (null when balance sheet is unavailable) */
+  ncav?: NCAV | null;
+
+  /** Earnings Power Value (null when EBIT is negative or WACC not computable) */
+  earningsPowerValue?: EarningsPowerValue | null;
+
   // Owner Earnings Analysis (Buffett-style)
   /** Owner earnings analysis for intrinsic value assessment */
   ownerEarningsAnalysis: {
@@ -465,6 +751,9 @@ export interface ValuationDeepDive {
     growthCapex: number;
   } | null;
 
+  /** Owner-earnings DCF as an independent check on the FMP DCF */
+  ownerEarningsDcf?: OwnerEarningsDCF | null;
+
   // Valuation Signals Summary
   /** Valuation-related signals */
   signals: {
@@ -545,6 +834,12 @@ export interface Holdings {
    * e.g., 3 means institutions have been buying for 3 quarters straight.
    */
   netChangeQuarters: number;
+  /** Quarter-over-quarter ownership trend from total institutional shares change */
+  ownershipTrend?: 'accumulating' | 'distributing' | 'stable';
+  /** Number of institutions that opened a new position this quarter */
+  newPositions?: number;
+  /** Number of institutions that fully exited their position this quarter */
+  soldOutPositions?: number;
 }
 
 // =============================================================================
@@ -567,12 +862,52 @@ export interface Performance {
   year1Change: number;
   /** Current price as percentage of 52-week high */
   percentOf52WeekHigh: number;
+  /** Percentage the current price sits above the 52-week low */
+  percentOffLow?: number;
+  /** Position of the price within the 52-week range (0 = at low, 100 = at high; 50 when high equals low) */
+  rangePosition?: number;
+  /** True when returns use split- and dividend-adjusted prices (total return) */
+  adjusted?: boolean;
+}
+
+// =============================================================================
+// Technical Indicators
+// =============================================================================
+
+/**
+ * Technical indicators for the stock.
+ *
+ * Only present when the technicals provider is configured.
+ * RSI above 70 is typically considered overbought, below 30 oversold.
+ */
+export interface TechnicalMetrics {
+  /** 14-day Relative Strength Index (0-100) */
+  rsi14: number | null;
+  /** 20-day simple moving average */
+  sma20: number | null;
+  /** 50-day simple moving average */
+  sma50: number | null;
+  /** 200-day simple moving average */
+  sma200: number | null;
+  /** Whether price is above the 20-day SMA */
+  aboveSma20: boolean | null;
+  /** Whether price is above the 50-day SMA */
+  aboveSma50: boolean | null;
+  /** Whether price is above the 200-day SMA */
+  aboveSma200: boolean | null;
 }
 
 // =============================================================================
 // Insider Transactions
 // =============================================================================
 
+/**
+ * Insider transaction category derived from Form 4 transaction codes.
+ * Only open-market trades reflect an insider's own buy/sell decision;
+ * awards, option exercises and gifts often carry a zero price.
+ */
+export type InsiderTransactionCategory = 'open-market' | 'award' | 'exercise' | 'gift' | 'other';
+
 /**
  * A single insider transaction from Form 4 filings.
  *
@@ -595,6 +930,8 @@ export interface InsiderTrade {
   value: number;
   /** Date of transaction (ISO 8601) */
   tradeDate: string;
+  /** Transaction category (open-market buy/sell vs award, exercise, gift) */
+  category?: InsiderTransactionCategory;
 }
 
 /**
@@ -607,7 +944,7 @@ export interface InsiderActivity {
   buyCount90d: number;
   /** Number of sell transactions in last 90 days */
   sellCount90d: number;
-  /** Net dollar value (positive = net buying) */
+  /** Net dollar value of open-market trades (positive = net buying) */
   netValue90d: number;
 }
 
@@ -636,6 +973,10 @@ export interface CongressTrade {
   tradeType: 'buy' | 'sell';
   /** Value range (e.g., "$1,001 - $15,000", "$15,001 - $50,000") */
   amount: string;
+  /** Midpoint of the disclosed value range in USD (null if unparseable) */
+  amountMidpoint?: number | null;
+  /** For sells, whether the position was fully or partially sold */
+  saleType?: 'full' | 'partial';
   /** Description of the asset traded */
   assetDescription: string;
   /** Date of the transaction (ISO 8601) */
@@ -660,6 +1001,10 @@ export interface CongressActivity {
   senateCount: number;
   /** Number of House trades */
   houseCount: number;
+  /** Number of distinct members of Congress trading in the last 90 days */
+  distinctMembers90d?: number;
+  /** Net disclosed value over the last 90 days using range midpoints (positive = net buying) */
+  netValueMidpoint90d?: number;
 }
 
 // =============================================================================
@@ -683,6 +1028,11 @@ export interface SectorMetric {
   sectorMax: number;
   /** Percentile rank within sector (0-100, higher = better) */
   percentile: number;
+  /**
+   * False when the value can't be meaningfully computed (e.g., EPS growth
+   * off a zero or negative prior year). Render as "N/M" and ignore the percentile.
+   */
+  meaningful?: boolean;
 }
 
 // =============================================================================
@@ -723,6 +1073,8 @@ export interface FinancialHealth {
   currentRatio: SectorMetric;
   /** Asset Turnover - measures efficiency of asset utilization (higher is better) */
   assetTurnover: SectorMetric;
+  /** Interest Coverage (EBIT / Interest Expense) - omitted for companies with no debt (higher is better) */
+  interestCoverage?: SectorMetric;
 }
 
 // =============================================================================
@@ -766,7 +1118,8 @@ export interface Growth {
  */
 export interface EarningsQuality {
   /**
-   * Accrual Ratio = (Net Income - Operating Cash Flow) / Total Assets
+   * Sloan Accrual Ratio (balance-sheet method) =
+   * (ΔNon-cash Working Capital - ΔNet Operating Assets) / Average Total Assets
    * Lower values indicate higher quality earnings (more cash-based)
    */
   accrualRatio: SectorMetric;
@@ -791,6 +1144,26 @@ export interface EarningsQuality {
 // Financial Metrics
 // =============================================================================
 
+/**
+ * Direction of a margin between the latest two annual periods.
+ */
+export type MarginDirection = 'expanding' | 'contracting' | 'flat';
+
+/**
+ * Margin change between the latest two annual income statements.
+ * Deltas are in basis points (e.g., 150 = +1.5 percentage points).
+ */
+export interface MarginTrend {
+  /** Change in gross margin (bps) */
+  grossMarginDeltaBps: number;
+  /** Gross margin direction */
+  grossMarginDirection: MarginDirection;
+  /** Change in operating margin (bps) */
+  operatingMarginDeltaBps: number;
+  /** Operating margin direction */
+  operatingMarginDirection: MarginDirection;
+}
+
 /**
  * Key financial metrics derived from financial statements.
  *
@@ -819,12 +1192,26 @@ export interface Financials {
   currentRatio: number;
   /** EBIT / Interest Expense (null if no debt) */
   interestCoverage: number | null;
+  /** Gross/operating margin trend vs prior year (null if only one period) */
+  marginTrend?: MarginTrend | null;
 }
 
 // =============================================================================
 // Metadata
 // =============================================================================
 
+/**
+ * Provenance and freshness of a single response section.
+ */
+export interface FieldMeta {
+  /** Provider that served the data (e.g., "fmp", "polygon") */
+  provider: string;
+  /** Timestamp when the data was fetched from the provider (ISO 8601) */
+  fetchedAt: string;
+  /** Whether the data was served from cache rather than fetched for this request */
+  cached: boolean;
+}
+
 /**
  * Data freshness metadata.
  *
@@ -840,6 +1227,12 @@ export interface DataMeta {
   priceAsOf: string;
   /** Timestamp when this response was generated */
   generatedAt: string;
+  /** Statement cadence used for scores and growth ("annual" unless requested otherwise) */
+  periodType?: 'annual' | 'quarterly';
+  /** Point-in-time date the analysis was run as of (YYYY-MM-DD), when requested */
+  asOf?: string;
+  /** Per-section provenance keyed by response field (e.g., "quote", "holdings") */
+  sources?: Record<string, FieldMeta>;
 }
 
 // =============================================================================
@@ -942,6 +1335,21 @@ export interface ETFPerformance {
   y10?: number;
 }
 
+/**
+ * Holdings concentration of an ETF.
+ *
+ * Distinguishes broad index funds (low top-10 weight, low HHI) from
+ * concentrated thematic funds.
+ */
+export interface ETFConcentration {
+  /** Combined weight of the 10 largest holdings as percentage */
+  top10Weight: number;
+  /** Herfindahl-Hirschman Index: sum of squared percentage weights (0-10,000) */
+  hhi: number;
+  /** True when computed from a partial holdings list rather than the full fund */
+  estimated: boolean;
+}
+
 /**
  * ETF-specific data not applicable to individual stocks.
  */
@@ -950,7 +1358,7 @@ export interface ETFData {
   expenseRatio: number;
   /** Assets under management / Net assets in USD */
   aum: number;
-  /** Net Asset Value per share */
+  /** Net Asset Value per share (in USD) */
   nav: number;
   /** Average daily trading volume */
   avgVolume: number;
@@ -966,6 +1374,10 @@ export interface ETFData {
   website?: string;
   /** Fund company name (e.g., "SPDR", "Vanguard") */
   etfCompany?: string;
+  /** ISO 4217 currency the fund reports in (e.g., "EUR" for UCITS funds) */
+  reportingCurrency?: string;
+  /** True when AUM and NAV were converted to USD from the reporting currency */
+  currencyConverted?: boolean;
   /** Top holdings by weight */
   holdings: ETFHolding[];
   /** Sector allocation breakdown */
@@ -978,12 +1390,28 @@ export interface ETFData {
   valuations?: ETFValuations;
   /** Historical performance */
   performance?: ETFPerformance;
+  /** Holdings concentration computed from holding weights */
+  concentration?: ETFConcentration;
 }
 
 // =============================================================================
 // Analyst Estimates
 // =============================================================================
 
+/**
+ * Reported vs estimated EPS for a single quarter.
+ */
+export interface EarningsSurprise {
+  /** Report date (ISO 8601) */
+  date: string;
+  /** Reported EPS */
+  actualEps: number;
+  /** Consensus EPS estimate before the report */
+  estimatedEps: number;
+  /** ((actualEps - estimatedEps) / |estimatedEps|) * 100 (null if estimate is zero) */
+  surprisePercent: number | null;
+}
+
 /**
  * Analyst estimates, ratings, and price targets.
  *
@@ -1035,6 +1463,121 @@ export interface AnalystEstimates {
   revenueEstimateNextYear: number;
   /** Projected revenue growth percentage (next year vs current year) */
   revenueGrowthNextYear: number;
+
+  // Earnings Track Record
+  /** Recent quarterly EPS surprises, most recent first */
+  earningsSurprises?: EarningsSurprise[];
+  /** Share of those quarters where actual EPS beat the estimate, as percentage */
+  beatRate?: number | null;
+}
+
+// =============================================================================
+// Owner Earnings
+// =============================================================================
+
+/**
+ * Buffett-style owner earnings and yield.
+ *
+ * Owner earnings = net income + D&A - maintenance capex. A yield above
+ * the configured threshold (default 8%) also produces a bullish signal.
+ */
+export interface OwnerEarnings {
+  /** Total owner earnings in USD */
+  ownerEarnings: number;
+  /** Owner earnings per share */
+  ownerEarningsPerShare: number;
+  /** Owner earnings / market cap as percentage */
+  ownerEarningsYield: number;
+}
+
+// =============================================================================
+// Dividends
+// =============================================================================
+
+/**
+ * Total dividends paid per share in a calendar year.
+ */
+export interface AnnualDividend {
+  /** Calendar year */
+  year: number;
+  /** Total dividends per share paid in the year (USD) */
+  dividendPerShare: number;
+}
+
+/**
+ * Dividend history and payout analysis.
+ *
+ * Only present for dividend-paying stocks. A payout ratio above 100%
+ * means the company paid out more than it earned (common for REITs,
+ * but worth flagging).
+ */
+export interface DividendAnalysis {
+  /** Annual dividends per share, oldest first */
+  annualDividends: AnnualDividend[];
+  /** Dividends / net income as percentage (null if net income <= 0) */
+  payoutRatio: number | null;
+  /** Whether the payout ratio exceeds 100% */
+  payoutExceedsEarnings: boolean;
+  /** Consecutive years of dividend increases up to the most recent full year */
+  consecutiveYearsIncreased: number;
+  /** Dividend per share CAGR over the window as percentage (null if < 2 years) */
+  dividendCagr: number | null;
+  /** Number of years covered by the history window */
+  years: number;
+}
+
+// =============================================================================
+// Earnings Calendar
+// =============================================================================
+
+/**
+ * Next scheduled earnings report.
+ *
+ * Omitted from the response when the provider has no upcoming report date.
+ */
+export interface EarningsCalendar {
+  /** Expected report date (ISO 8601) */
+  reportDate: string;
+  /** Fiscal period end date the report covers (ISO 8601) */
+  fiscalPeriodEnd?: string;
+  /** Consensus EPS estimate for the report (null if no estimate) */
+  epsEstimate: number | null;
+  /** Consensus revenue estimate for the report (null if no estimate) */
+  revenueEstimate: number | null;
+  /** Report timing relative to the trading session */
+  timing: 'before-market' | 'after-market' | 'unknown';
+}
+
+// =============================================================================
+// Revenue Segments
+// =============================================================================
+
+/**
+ * A single revenue segment (product line or geographic region).
+ */
+export interface RevenueSegment {
+  /** Segment name as reported in the filing */
+  name: string;
+  /** Segment revenue for the period */
+  revenue: number;
+  /** Share of total segment revenue as percentage */
+  percentOfTotal: number;
+}
+
+/**
+ * Latest-period revenue breakdown from 10-K segment reporting.
+ *
+ * Both lists are empty for companies that report no segmentation.
+ */
+export interface RevenueSegments {
+  /** Fiscal year of the breakdown */
+  fiscalYear: number;
+  /** Period end date (ISO 8601) */
+  periodEnd: string;
+  /** Revenue by product or business segment, largest first */
+  product: RevenueSegment[];
+  /** Revenue by geographic region, largest first */
+  geographic: RevenueSegment[];
 }
 
 // =============================================================================
@@ -1063,6 +1606,10 @@ export interface ShortInterest {
   daysToCover: number;
   /** Settlement date of the short interest data (ISO 8601) */
   settlementDate?: string;
+  /** Change in shares short vs prior month as percentage (null if no prior data) */
+  shortInterestChange?: number | null;
+  /** Elevated squeeze risk: high short percent of float combined with high days to cover */
+  squeezeRisk?: boolean;
 }
 
 // =============================================================================
@@ -1089,6 +1636,8 @@ export interface StockDetailResponse {
   quote: Quote;
   /** Price performance over time periods */
   performance: Performance;
+  /** Technical indicators (omitted when the technicals provider is not configured) */
+  technicals?: TechnicalMetrics;
   /** Aggregated scoring (only for stocks) */
   scores?: Scores;
   /** Actionable signals and flags */
@@ -1119,6 +1668,14 @@ export interface StockDetailResponse {
   earningsQuality?: EarningsQuality;
   /** Analyst estimates, ratings, and price targets (only for stocks, primarily FMP provider) */
   analystEstimates?: AnalystEstimates;
+  /** Owner earnings and yield (only for stocks) */
+  ownerEarnings?: OwnerEarnings;
+  /** Dividend history and payout analysis (only for dividend-paying stocks) */
+  dividends?: DividendAnalysis;
+  /** Next scheduled earnings report (only for stocks, omitted when unknown) */
+  earningsCalendar?: EarningsCalendar;
+  /** Latest-period revenue segment breakdown (only for stocks) */
+  revenueSegments?: RevenueSegments;
   /** Short interest data (only for stocks) */
   shortInterest?: ShortInterest;
   /** Peer/competitor stock tickers (only for stocks) */
@@ -1127,6 +1684,34 @@ export interface StockDetailResponse {
   etfData?: ETFData;
   /** Data freshness timestamps */
   meta: DataMeta;
+  /** True when one or more provider calls failed and some sections are missing */
+  degraded?: boolean;
+  /**
+   * Non-fatal warnings: failed provider calls (e.g., "holdings unavailable") and
+   * implausible provider values treated as missing (e.g., gross margin over 100%)
+   */
+  warnings?: string[];
+}
+
+/**
+ * Lightweight response for GET /api/stock/{ticker}?view=light.
+ *
+ * A trimmed subset for summary cards and mobile clients; omits holdings,
+ * insider lists, sector tables and other heavy sections.
+ */
+export interface StockSummaryResponse {
+  /** Asset type discriminator */
+  assetType: AssetType;
+  /** Basic company/fund information */
+  company: Company;
+  /** Real-time market quote */
+  quote: Quote;
+  /** Aggregated scoring (only for stocks) */
+  scores?: Scores;
+  /** Top signals by priority */
+  signals: Signal[];
+  /** Data freshness timestamps */
+  meta: DataMeta;
 }
 
 // =============================================================================
@@ -1140,7 +1725,16 @@ export interface StockDetailResponse {
  * on the frontend.
  */
 export interface ApiError {
-  /** Machine-readable error code (e.g., "TICKER_NOT_FOUND") */
+  /**
+   * Machine-readable error code. Known codes:
+   * - "TICKER_NOT_FOUND" (404)
+   * - "INVALID_PARAMETER" (400): a query parameter is out of bounds (e.g., terminal growth >= discount rate)
+   * - "TICKER_DELISTED" (410): ticker was delisted or acquired; details carry the
+   *   last-known data ("lastPrice", "lastTradeDate") and a "note" on the delisting
+   * - "RATE_LIMITED" (429): upstream provider rate limit, retry later
+   * - "PROVIDER_UNAUTHORIZED" (503): provider API key rejected, an operator config error
+   * - "UPSTREAM_ERROR" (502): provider returned an unexpected failure
+   */
   code: string;
   /** Human-readable error message */
   message: string;
@@ -1,10 +1,13 @@
 import { ImageResponse } from 'next/og';
+import { fetchStockSummary } from '@/lib/api';
+import { normalizeTicker } from '@/lib/utils';
 
 export const runtime = 'edge';
 
-const size = { width: 1200, height: 630 };
+// Cache the upstream summary fetches for five minutes
+export const revalidate = 300;
 
-const API_BASE = process.env.NEXT_PUBLIC_API_URL || 'http://localhost:8080';
+const size = { width: 1200, height: 630 };
 
 interface StockData {
   company: { ticker: string; name: string };
@@ -38,11 +41,7 @@ function getOverallGrade(scores: StockData['scores']): { grade: string; color: s
 
 async function getStockData(ticker: string): Promise<StockData | null> {
   try {
-    const res = await fetch(`${API_BASE}/api/stock/${ticker.toUpperCase()}`, {
-      next: { revalidate: 300 },
-    });
-    if (!res.ok) return null;
-    return res.json();
+    return await fetchStockSummary(ticker);
   } catch {
     return null;
   }
@@ -53,7 +52,7 @@ export async function GET(
   { params }: { params: Promise<{ tickers: string[] }> }
 ) {
   const { tickers: rawTickers } = await params;
-  const tickers = rawTickers.map((t) => t.toUpperCase()).slice(0, 4);
+  const tickers = rawTickers.map(normalizeTicker).filter(Boolean).slice(0, 4);
 
   // Fetch all stock data in parallel
   const stocksData = await Promise.all(tickers.map(getStockData));
@@ -10,7 +10,7 @@ import { Badge } from '@/components/ui/badge';
 import { ShareButton } from '@/components/ui/share-button';
 import { useSearch } from '@/hooks/use-search';
 import { useStock } from '@/hooks/use-stock';
-import { cn } from '@/lib/utils';
+import { cn, normalizeTicker } from '@/lib/utils';
 import { COMPARE_LIMITS } from '@/lib/constants';
 
 const BASE_URL = 'https://cruxit.finance';
@@ -32,7 +32,7 @@ function StockPicker({ onSelect, placeholder = 'Search ticker...' }: StockPicker
 
   const handleSelect = useCallback(
     (ticker: string) => {
-      onSelect(ticker.toUpperCase());
+      onSelect(ticker);
       setIsOpen(false);
       setQuery('');
     },
@@ -141,6 +141,11 @@ function StockPicker({ onSelect, placeholder = 'Search ticker...' }: StockPicker
   );
 }
 
+/** Normalize, drop invalid and de-duplicate tickers so BRK-B and BRK.B share one slot. */
+function parseTickerList(values: string[]): string[] {
+  return [...new Set(values.map(normalizeTicker).filter(Boolean))].slice(0, MAX_TICKERS);
+}
+
 function CompareContent() {
   const router = useRouter();
   const params = useParams();
@@ -154,27 +159,19 @@ function CompareContent() {
     // First check path params (from shared links like /compare/AAPL/MSFT)
     if (pathTickers && pathTickers.length > 0) {
       // Decode and flatten: handle both /compare/AAPL/MSFT and malformed /compare/AAPL,MSFT
-      return pathTickers
-        .flatMap((t) => decodeURIComponent(t).split(','))
-        .map((t) => t.trim().toUpperCase())
-        .filter(Boolean)
-        .slice(0, MAX_TICKERS);
+      return parseTickerList(pathTickers.flatMap((t) => decodeURIComponent(t).split(',')));
     }
     // Then check query params (like /compare?tickers=AAPL,MSFT)
     if (tickersParam) {
-      return tickersParam
-        .split(',')
-        .map((t) => t.trim().toUpperCase())
-        .filter(Boolean)
-        .slice(0, MAX_TICKERS);
+      return parseTickerList(tickersParam.split(','));
     }
     return [];
   });
 
   const addTicker = (ticker: string) => {
-    const upperTicker = ticker.toUpperCase();
-    if (!tickers.includes(upperTicker) && tickers.length < MAX_TICKERS) {
-      const newTickers = [...tickers, upperTicker];
+    const normalizedTicker = normalizeTicker(ticker);
+    if (normalizedTicker && !tickers.includes(normalizedTicker) && tickers.length < MAX_TICKERS) {
+      const newTickers = [...tickers, normalizedTicker];
       setTickers(newTickers);
       // Use path-based URL for consistency with share links
       const newUrl = newTickers.length > 0 ? `/compare/${newTickers.join('/')}` : '/compare';
@@ -192,7 +189,7 @@ function CompareContent() {
 
   // Fetch peer suggestions based on the first selected stock
   const { data: firstStockData } = useStock(tickers.length >= 1 ? tickers[0] : '');
-  const peers = firstStockData?.peers?.filter(p => !tickers.includes(p.toUpperCase())).slice(0, 8) || [];
+  const peers = firstStockData?.peers?.filter(p => !tickers.includes(normalizeTicker(p))).slice(0, 8) || [];
 
   const emptySlots = MAX_TICKERS - tickers.length;
 
@@ -3,6 +3,7 @@
 import { Suspense } from 'react';
 import { useRouter, useSearchParams } from 'next/navigation';
 import { FileText, TrendingUp, Users, Database, Sparkles, PieChart, Bookmark, UserCheck, FileSearch } from 'lucide-react';
+import { MarketMovers } from '@/components/dashboard/market-movers';
 import { StockDashboard } from '@/components/dashboard/stock-dashboard';
 import { TickerSearch } from '@/components/search/ticker-search';
 import { Badge } from '@/components/ui/badge';
@@ -122,6 +123,13 @@ function HomeContent() {
           </div>
         </section>
 
+        {/* Market Movers Section */}
+        <section className="pb-16">
+          <div className="max-w-xl mx-auto px-4">
+            <MarketMovers />
+          </div>
+        </section>
+
         {/* Features Section */}
         <section className="py-16 border-t border-border/50">
           <div className="max-w-5xl mx-auto px-4">
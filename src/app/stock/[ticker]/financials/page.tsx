@@ -19,6 +19,7 @@ import {
   type PeriodCount,
 } from '@/components/financials';
 import type { FinancialsPeriodType } from '@/lib/api';
+import { downloadBlob } from '@/lib/utils';
 
 interface PageProps {
   params: Promise<{ ticker: string }>;
@@ -144,13 +145,7 @@ export default function FinancialsPage({ params }: PageProps) {
     const result = generateCSV();
     if (!result) return;
 
-    const blob = new Blob([result.content], { type: 'text/csv' });
-    const url = URL.createObjectURL(blob);
-    const a = document.createElement('a');
-    a.href = url;
-    a.download = result.filename;
-    a.click();
-    URL.revokeObjectURL(url);
+    downloadBlob(new Blob([result.content], { type: 'text/csv' }), result.filename);
   }, [generateCSV]);
 
   // Loading state
@@ -343,7 +338,7 @@ export default function FinancialsPage({ params }: PageProps) {
           </TabsContent>
 
           <TabsContent value="segments" className="mt-6">
-            <SegmentsTab ticker={ticker} />
+            <SegmentsTab ticker={ticker} segments={stockData?.revenueSegments} />
           </TabsContent>
         </Tabs>
       </div>
@@ -1,4 +1,5 @@
 import { ImageResponse } from 'next/og';
+import { normalizeTicker } from '@/lib/utils';
 
 export const runtime = 'edge';
 export const alt = 'Stock Analysis';
@@ -48,18 +49,20 @@ function getOverallGrade(scores: StockData['scores']): { grade: string; color: s
 
 export default async function Image({ params }: { params: Promise<{ ticker: string }> }) {
   const { ticker: rawTicker } = await params;
-  const ticker = rawTicker.toUpperCase();
+  const ticker = normalizeTicker(rawTicker);
 
   let data: StockData | null = null;
-  try {
-    const res = await fetch(`${API_BASE}/api/stock/${ticker}`, {
-      next: { revalidate: 300 },
-    });
-    if (res.ok) {
-      data = await res.json();
+  if (ticker) {
+    try {
+      const res = await fetch(`${API_BASE}/api/stock/${ticker}`, {
+        next: { revalidate: 300 },
+      });
+      if (res.ok) {
+        data = await res.json();
+      }
+    } catch {
+      // Fall back to basic display
     }
-  } catch {
-    // Fall back to basic display
   }
 
   const companyName = data?.company.name ?? ticker;
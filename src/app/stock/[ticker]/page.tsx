@@ -1,7 +1,9 @@
 import { cache } from 'react';
 import { Metadata } from 'next';
+import { notFound } from 'next/navigation';
 import { StockDetailResponse } from '@recon/shared';
 import { StockDashboard } from '@/components/dashboard/stock-dashboard';
+import { normalizeTicker } from '@/lib/utils';
 
 const API_BASE = process.env.NEXT_PUBLIC_API_URL || 'http://localhost:8080';
 const BASE_URL = process.env.NEXT_PUBLIC_SITE_URL || 'https://cruxit.finance';
@@ -28,8 +30,9 @@ function getOverallGrade(scores: StockDetailResponse['scores']): string {
 }
 
 const getStockData = cache(async (ticker: string): Promise<StockDetailResponse | null> => {
+  if (!ticker) return null;
   try {
-    const res = await fetch(`${API_BASE}/api/stock/${ticker.toUpperCase()}`, {
+    const res = await fetch(`${API_BASE}/api/stock/${normalizeTicker(ticker)}`, {
       next: { revalidate: 300 },
     });
     if (!res.ok) return null;
@@ -45,7 +48,7 @@ interface PageProps {
 
 export async function generateMetadata({ params }: PageProps): Promise<Metadata> {
   const { ticker: rawTicker } = await params;
-  const ticker = rawTicker.toUpperCase();
+  const ticker = normalizeTicker(rawTicker);
   const data = await getStockData(ticker);
 
   const companyName = data?.company.name ?? ticker;
@@ -82,7 +85,8 @@ export async function generateMetadata({ params }: PageProps): Promise<Metadata>
 
 export default async function StockPage({ params }: PageProps) {
   const { ticker: rawTicker } = await params;
-  const ticker = rawTicker.toUpperCase();
+  const ticker = normalizeTicker(rawTicker);
+  if (!ticker) notFound();
   const data = await getStockData(ticker);
 
   const companyName = data?.company.name ?? ticker;
@@ -10,7 +10,13 @@ import {
   TableHeader,
   TableRow,
 } from '@/components/ui/table';
-import { getNestedValue, findWinner, type MetricConfig } from '@/lib/compare-utils';
+import {
+  getNestedValue,
+  isNestedValueNotMeaningful,
+  findWinner,
+  type MetricConfig,
+} from '@/lib/compare-utils';
+import { NOT_MEANINGFUL_DISPLAY } from '@/lib/metric-helpers';
 import { cn } from '@/lib/utils';
 import type { StockDetailResponse, CompareLayout } from '@recon/shared';
 
@@ -50,7 +56,9 @@ export const MetricTable = memo(function MetricTable({ title, stocks, metrics, l
                     // Check if this value is the winner (and is valid)
                     const isWinner = winnerIdx === idx;
                     // For display, show the value even if it's 0 (but it won't be highlighted as winner)
-                    const displayValue = value !== null ? metric.format(value) : '-';
+                    const displayValue = value !== null
+                      ? metric.format(value)
+                      : isNestedValueNotMeaningful(stocks[idx], metric.path) ? NOT_MEANINGFUL_DISPLAY : '-';
 
                     return (
                       <TableCell
@@ -5,7 +5,13 @@ import { Trophy } from 'lucide-react';
 import { SectionCard } from '@/components/dashboard/sections/section-card';
 import { Card } from '@/components/ui/card';
 import { InlineShareLinks } from '@/components/ui/share-button';
-import { COMPARE_METRICS, getNestedValue, findWinner } from '@/lib/compare-utils';
+import {
+  COMPARE_METRICS,
+  getNestedValue,
+  isNestedValueNotMeaningful,
+  findWinner,
+} from '@/lib/compare-utils';
+import { NOT_MEANINGFUL_DISPLAY } from '@/lib/metric-helpers';
 import { cn } from '@/lib/utils';
 import type { StockDetailResponse, CompareLayout } from '@recon/shared';
 
@@ -77,7 +83,9 @@ export const ScoreComparison = memo(function ScoreComparison({ stocks, layout }:
                           isWinner && 'text-positive font-semibold'
                         )}
                       >
-                        {value !== null ? metric.format(value) : '-'}
+                        {value !== null
+                          ? metric.format(value)
+                          : isNestedValueNotMeaningful(stock, metric.path) ? NOT_MEANINGFUL_DISPLAY : '-'}
                         {isWinner && (
                           <Trophy className="inline-block ml-1 h-3 w-3 text-warning" />
                         )}
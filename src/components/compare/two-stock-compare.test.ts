@@ -1,7 +1,11 @@
 
-import { describe, it, bench } from 'vitest';
+import { describe, it, bench, expect } from 'vitest';
 import { calculateCategoryWins } from '../../lib/compare-logic';
-import { calculateRankings } from '../../lib/compare-utils';
+import {
+  calculateRankings,
+  getNestedValue,
+  isNestedValueNotMeaningful,
+} from '../../lib/compare-utils';
 import type { StockDetailResponse } from '@recon/shared';
 
 // Mock data generator
@@ -129,3 +133,37 @@ describe('Comparison Calculation Performance', () => {
     console.log(`Average per iteration: ${((end - start) / 10000).toFixed(4)}ms`);
   });
 });
+
+describe('not-meaningful metric values', () => {
+  // A swing from a loss to a profit yields a huge EPS growth figure the API flags as not meaningful
+  const withLossToProfitEps = (stock: StockDetailResponse): StockDetailResponse => ({
+    ...stock,
+    growth: {
+      ...stock.growth!,
+      epsGrowthYoY: { ...stock.growth!.epsGrowthYoY, value: 900, meaningful: false },
+    },
+  });
+
+  it('reads a not-meaningful value as null', () => {
+    const stock = withLossToProfitEps(createMockStock('LTP', 1));
+    expect(getNestedValue(stock, 'growth.epsGrowthYoY.value')).toBeNull();
+    expect(isNestedValueNotMeaningful(stock, 'growth.epsGrowthYoY.value')).toBe(true);
+  });
+
+  it('does not flag missing or meaningful values', () => {
+    const stock = createMockStock('OK', 1);
+    expect(getNestedValue(stock, 'growth.epsGrowthYoY.value')).toBe(12);
+    expect(isNestedValueNotMeaningful(stock, 'growth.epsGrowthYoY.value')).toBe(false);
+    expect(isNestedValueNotMeaningful(stock, 'growth.missing.value')).toBe(false);
+  });
+
+  it('does not award a ranking win for a loss-to-profit EPS swing', () => {
+    // Identical stocks tie on every metric, so ties go to the first stock
+    const left = createMockStock('LFT', 1);
+    const right = withLossToProfitEps(createMockStock('RGT', 1));
+
+    const rankings = calculateRankings([left, right]);
+
+    expect(rankings.find((r) => r.ticker === 'RGT')?.wins).toBe(0);
+  });
+});
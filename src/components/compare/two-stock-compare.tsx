@@ -12,6 +12,7 @@ import {
   determineWinner,
 } from '@/lib/compare-utils';
 import { calculateCategoryWins } from '@/lib/compare-logic';
+import { NOT_MEANINGFUL_DISPLAY, isNotMeaningful, meaningfulValue } from '@/lib/metric-helpers';
 import type { StockDetailResponse, RankingResult } from '@recon/shared';
 
 const BASE_URL = 'https://cruxit.finance';
@@ -154,8 +155,10 @@ export const TwoStockCompare = memo(function TwoStockCompare({
             />
             <MetricRow
               label="EPS YoY"
-              leftValue={left.growth?.epsGrowthYoY.value}
-              rightValue={right.growth?.epsGrowthYoY.value}
+              leftValue={meaningfulValue(left.growth?.epsGrowthYoY)}
+              rightValue={meaningfulValue(right.growth?.epsGrowthYoY)}
+              leftNotMeaningful={isNotMeaningful(left.growth?.epsGrowthYoY)}
+              rightNotMeaningful={isNotMeaningful(right.growth?.epsGrowthYoY)}
               format={(v) => `${v >= 0 ? '+' : ''}${v.toFixed(1)}%`}
             />
             <MetricRow
@@ -399,9 +402,22 @@ interface MetricRowProps {
   lowerIsBetter?: boolean;
   /** If true, negative values are shown as "—" with "(unprofitable)" label */
   showUnprofitableForNegative?: boolean;
+  /** Show N/M instead of the left value (pass a null leftValue so it can't win) */
+  leftNotMeaningful?: boolean;
+  /** Show N/M instead of the right value (pass a null rightValue so it can't win) */
+  rightNotMeaningful?: boolean;
 }
 
-function MetricRow({ label, leftValue, rightValue, format, lowerIsBetter, showUnprofitableForNegative }: MetricRowProps) {
+function MetricRow({
+  label,
+  leftValue,
+  rightValue,
+  format,
+  lowerIsBetter,
+  showUnprofitableForNegative,
+  leftNotMeaningful,
+  rightNotMeaningful,
+}: MetricRowProps) {
   // For metrics where negative is invalid (like P/E), treat them as null for winner calculation
   const effectiveLeft = showUnprofitableForNegative && leftValue != null && leftValue <= 0 ? null : leftValue;
   const effectiveRight = showUnprofitableForNegative && rightValue != null && rightValue <= 0 ? null : rightValue;
@@ -410,7 +426,8 @@ function MetricRow({ label, leftValue, rightValue, format, lowerIsBetter, showUn
   const leftWins = winner === 'left';
   const rightWins = winner === 'right';
 
-  const formatValue = (value: number | null | undefined, isWinner: boolean) => {
+  const formatValue = (value: number | null | undefined, isWinner: boolean, notMeaningful?: boolean) => {
+    if (notMeaningful) return <span className="text-muted-foreground">{NOT_MEANINGFUL_DISPLAY}</span>;
     if (value == null) return '-';
     if (showUnprofitableForNegative && value <= 0) {
       return (
@@ -428,7 +445,7 @@ function MetricRow({ label, leftValue, rightValue, format, lowerIsBetter, showUn
         'text-right font-mono',
         leftWins && 'text-positive font-semibold'
       )}>
-        {formatValue(leftValue, leftWins)}
+        {formatValue(leftValue, leftWins, leftNotMeaningful)}
         {leftWins && <Trophy className="inline ml-1 h-3 w-3 text-warning" />}
       </div>
       <div className="text-center text-sm text-muted-foreground">{label}</div>
@@ -437,7 +454,7 @@ function MetricRow({ label, leftValue, rightValue, format, lowerIsBetter, showUn
         rightWins && 'text-positive font-semibold'
       )}>
         {rightWins && <Trophy className="inline mr-1 h-3 w-3 text-warning" />}
-        {formatValue(rightValue, rightWins)}
+        {formatValue(rightValue, rightWins, rightNotMeaningful)}
       </div>
     </div>
   );
@@ -3,7 +3,7 @@
 import { useQuery } from '@tanstack/react-query';
 import { Sparkles, Newspaper, AlertCircle, RefreshCw } from 'lucide-react';
 import { fetchInsight, type InsightSection, type NewsSentiment, type NewsLink } from '@/lib/api';
-import { cn } from '@/lib/utils';
+import { cn, normalizeTicker } from '@/lib/utils';
 
 interface CruxAIInsightProps {
   ticker: string;
@@ -46,12 +46,13 @@ function getSentimentStyle(sentiment: string): { color: string; bg: string } {
 }
 
 export function CruxAIInsight({ ticker, section, className }: CruxAIInsightProps) {
+  const normalizedTicker = normalizeTicker(ticker);
   const { data, isLoading, error, refetch } = useQuery({
-    queryKey: ['insight', ticker.toUpperCase(), section],
-    queryFn: () => fetchInsight(ticker, section),
+    queryKey: ['insight', normalizedTicker, section],
+    queryFn: () => fetchInsight(normalizedTicker, section),
     staleTime: INSIGHT_STALE_TIME,
     retry: 1,
-    enabled: Boolean(ticker),
+    enabled: Boolean(normalizedTicker),
   });
 
   if (error) {
@@ -0,0 +1,86 @@
+'use client';
+
+import { useState } from 'react';
+import Link from 'next/link';
+import { useMovers } from '@/hooks/use-movers';
+import { Skeleton } from '@/components/ui/skeleton';
+import { cn, formatCurrency, formatPercent } from '@/lib/utils';
+import type { MarketMover, MoverType } from '@/lib/api';
+
+const MOVER_TABS: { type: MoverType; label: string }[] = [
+  { type: 'gainers', label: 'Gainers' },
+  { type: 'losers', label: 'Losers' },
+  { type: 'active', label: 'Most Active' },
+];
+
+const MOVERS_SHOWN = 5;
+
+function MoverRow({ mover }: { mover: MarketMover }) {
+  const isPositive = mover.changePercent >= 0;
+
+  return (
+    <Link
+      href={`/?ticker=${mover.ticker}`}
+      className="flex items-center justify-between py-2.5 border-b border-border/30 last:border-0 hover:bg-muted/30 rounded px-2 -mx-2 transition-colors"
+    >
+      <div className="min-w-0">
+        <div className="font-medium">{mover.ticker}</div>
+        <div className="text-xs text-muted-foreground truncate max-w-[200px]">{mover.name}</div>
+      </div>
+      <div className="text-right font-mono tabular-nums">
+        <div className="text-sm">{formatCurrency(mover.price)}</div>
+        <div className={cn('text-xs', isPositive ? 'text-success' : 'text-destructive')}>
+          {formatPercent(mover.changePercent)}
+        </div>
+      </div>
+    </Link>
+  );
+}
+
+export function MarketMovers() {
+  const [type, setType] = useState<MoverType>('gainers');
+  const { data, isLoading, error } = useMovers(type);
+
+  return (
+    <div className="rounded-xl border border-border bg-card p-5">
+      <div className="flex items-center justify-between mb-4">
+        <h2 className="font-semibold">Markets Today</h2>
+        <div className="flex gap-1 rounded-md bg-muted p-1">
+          {MOVER_TABS.map((tab) => (
+            <button
+              key={tab.type}
+              type="button"
+              onClick={() => setType(tab.type)}
+              className={cn(
+                'rounded px-2.5 py-1 text-xs font-medium transition-colors',
+                type === tab.type
+                  ? 'bg-background text-foreground shadow-sm'
+                  : 'text-muted-foreground hover:text-foreground'
+              )}
+            >
+              {tab.label}
+            </button>
+          ))}
+        </div>
+      </div>
+
+      {isLoading ? (
+        <div className="space-y-2">
+          {Array.from({ length: MOVERS_SHOWN }, (_, i) => (
+            <Skeleton key={i} className="h-10 w-full" />
+          ))}
+        </div>
+      ) : error ? (
+        <div className="py-6 text-center text-sm text-muted-foreground">
+          Could not load market movers
+        </div>
+      ) : (
+        <div>
+          {data?.movers.slice(0, MOVERS_SHOWN).map((mover) => (
+            <MoverRow key={mover.ticker} mover={mover} />
+          ))}
+        </div>
+      )}
+    </div>
+  );
+}
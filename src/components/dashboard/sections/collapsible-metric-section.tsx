@@ -15,6 +15,8 @@ interface CollapsibleMetricSectionProps {
   metrics: Metric[];
   deepDiveUrl?: string;
   defaultOpen?: boolean;
+  /** One-line summary shown next to the title */
+  summary?: string;
 }
 
 /**
@@ -28,6 +30,7 @@ export const CollapsibleMetricSection = memo(function CollapsibleMetricSection({
   metrics,
   deepDiveUrl,
   defaultOpen = false,
+  summary,
 }: CollapsibleMetricSectionProps) {
   const [isOpen, setIsOpen] = useState(defaultOpen);
 
@@ -56,6 +59,9 @@ export const CollapsibleMetricSection = memo(function CollapsibleMetricSection({
             <span className="text-xs uppercase text-primary font-semibold tracking-widest">
               {title}
             </span>
+            {summary && (
+              <span className="text-xs text-foreground/80">{summary}</span>
+            )}
             {!isOpen && (
               <span className="text-xs text-muted-foreground">
                 ({validMetrics.length} metrics)
@@ -107,6 +113,8 @@ export const CollapsibleMetricSection = memo(function CollapsibleMetricSection({
                     higherIsBetter={metric.higherIsBetter}
                     info={metric.info}
                     learnMoreUrl={metric.learnMoreUrl}
+                    notMeaningful={metric.notMeaningful}
+                    trend={metric.trend}
                     compact
                   />
                 ))}
@@ -1,11 +1,12 @@
 'use client';
 
 import { memo, useMemo } from 'react';
-import { Info, ExternalLink } from 'lucide-react';
+import { Info, ExternalLink, Check, X } from 'lucide-react';
 import { SectionCard } from './section-card';
 import { Card } from '@/components/ui/card';
 import { Popover, PopoverContent, PopoverTrigger } from '@/components/ui/popover';
-import type { StockDetailResponse, AnalystEstimates } from '@recon/shared';
+import { formatCompact } from '@/lib/formatters';
+import type { StockDetailResponse, AnalystEstimates, PiotroskiCriterion } from '@recon/shared';
 
 interface ConvictionScoresSectionProps {
   data: StockDetailResponse;
@@ -86,6 +87,42 @@ const getAltmanStatus = (zone: string): 'positive' | 'neutral' | 'negative' => {
   return 'negative';
 };
 
+interface PiotroskiChecklistProps {
+  criteria: PiotroskiCriterion[];
+}
+
+const PiotroskiChecklist = memo(function PiotroskiChecklist({ criteria }: PiotroskiChecklistProps) {
+  const passedCount = criteria.filter((criterion) => criterion.passed).length;
+
+  return (
+    <Card className="p-4 mt-4 border-border/50">
+      <div className="flex items-center justify-between mb-3">
+        <div className="text-xs text-muted-foreground uppercase tracking-widest">Piotroski Criteria</div>
+        <div className="text-xs text-muted-foreground font-mono">
+          {passedCount}/{criteria.length} passed
+        </div>
+      </div>
+      <ul className="grid sm:grid-cols-2 gap-x-6 gap-y-2">
+        {criteria.map((criterion) => (
+          <li key={criterion.name} className="flex items-center justify-between gap-2 text-sm">
+            <span className="flex items-center gap-2 min-w-0">
+              {criterion.passed ? (
+                <Check className="h-3.5 w-3.5 flex-shrink-0 text-success" aria-label="Passed" />
+              ) : (
+                <X className="h-3.5 w-3.5 flex-shrink-0 text-destructive" aria-label="Failed" />
+              )}
+              <span className="truncate">{criterion.name}</span>
+            </span>
+            <span className="text-xs text-muted-foreground font-mono flex-shrink-0">
+              {formatCompact(criterion.currentValue)} vs {formatCompact(criterion.comparedValue)}
+            </span>
+          </li>
+        ))}
+      </ul>
+    </Card>
+  );
+});
+
 interface AnalystConsensusProps {
   estimates: AnalystEstimates;
   currentPrice: number;
@@ -244,6 +281,10 @@ function ConvictionScoresSectionComponent({ data }: ConvictionScoresSectionProps
         />
       </div>
 
+      {scores.piotroski.criteria && scores.piotroski.criteria.length > 0 && (
+        <PiotroskiChecklist criteria={scores.piotroski.criteria} />
+      )}
+
       {analystEstimates && (
         <AnalystConsensus estimates={analystEstimates} currentPrice={quote.price} />
       )}
@@ -39,6 +39,10 @@ function ETFOverviewSectionComponent({ data }: ETFOverviewSectionProps) {
   const { etfData } = data;
   if (!etfData) return null;
 
+  const convertedNote = etfData.currencyConverted && etfData.reportingCurrency
+    ? `USD, from ${etfData.reportingCurrency}`
+    : null;
+
   // Row 1: Expense Ratio | Market Cap | NAV | Inception
   const row1Metrics = [
     {
@@ -49,12 +53,12 @@ function ETFOverviewSectionComponent({ data }: ETFOverviewSectionProps) {
     {
       label: 'Market Cap',
       value: formatCurrency(etfData.aum),
-      description: 'Fund Size',
+      description: convertedNote ?? 'Fund Size',
     },
     {
       label: 'NAV',
       value: formatPrice(etfData.nav),
-      description: 'Net Asset Value',
+      description: convertedNote ?? 'Net Asset Value',
     },
     {
       label: 'Inception',
@@ -33,6 +33,16 @@ function FinancialHealthCompactComponent({ data, defaultOpen = false }: Financia
     }),
   ];
 
+  if (financialHealth.interestCoverage) {
+    metrics.push(
+      toMetric('interestCoverage', 'Interest Coverage', financialHealth.interestCoverage, {
+        format: 'multiple',
+        higherIsBetter: true,
+        info: 'Interest Coverage measures how easily a company can pay interest on its debt. Below 1.5x is a warning sign.',
+      })
+    );
+  }
+
   return (
     <CollapsibleMetricSection
       title="Balance Sheet"
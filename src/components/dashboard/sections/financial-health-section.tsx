@@ -35,6 +35,18 @@ export function FinancialHealthSection({ data }: FinancialHealthSectionProps) {
     }),
   ];
 
+  // Interest coverage is omitted by the API for companies with no debt
+  if (financialHealth.interestCoverage) {
+    metrics.push(
+      toMetric('interestCoverage', 'Interest Coverage', financialHealth.interestCoverage, {
+        format: 'multiple',
+        higherIsBetter: true,
+        info: 'Interest Coverage (EBIT / Interest Expense) measures how easily a company can pay interest on its debt. Below 1.5x is a warning sign.',
+        learnMoreUrl: 'https://www.investopedia.com/terms/i/interestcoverageratio.asp',
+      })
+    );
+  }
+
   // Build share text
   const shareText = buildShareText(company.ticker, 'Balance Sheet', [
     { label: 'Debt/Equity', value: financialHealth.debtToEquity?.value != null ? `${financialHealth.debtToEquity.value.toFixed(2)}x` : null },
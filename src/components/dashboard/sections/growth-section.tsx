@@ -1,7 +1,7 @@
 'use client';
 
 import { MetricSection, type Metric } from './metric-section';
-import { toMetric } from '@/lib/metric-helpers';
+import { meaningfulValue, toMetric } from '@/lib/metric-helpers';
 import type { StockDetailResponse } from '@recon/shared';
 
 interface GrowthSectionProps {
@@ -71,8 +71,9 @@ export function GrowthSection({ data }: GrowthSectionProps) {
   if (growth.revenueGrowthYoY?.value != null) {
     shareMetrics.push(`Revenue Growth: ${formatGrowth(growth.revenueGrowthYoY.value)}`);
   }
-  if (growth.epsGrowthYoY?.value != null) {
-    shareMetrics.push(`EPS Growth: ${formatGrowth(growth.epsGrowthYoY.value)}`);
+  const epsGrowth = meaningfulValue(growth.epsGrowthYoY);
+  if (epsGrowth != null) {
+    shareMetrics.push(`EPS Growth: ${formatGrowth(epsGrowth)}`);
   }
   if (growth.projectedEpsGrowth?.value != null) {
     shareMetrics.push(`Projected EPS: ${formatGrowth(growth.projectedEpsGrowth.value)}`);
@@ -14,8 +14,20 @@ function HoldingsTableSectionComponent({ data }: HoldingsTableSectionProps) {
   const { etfData } = data;
   if (!etfData || etfData.holdings.length === 0) return null;
 
+  const { concentration } = etfData;
+
   return (
-    <SectionCard title="Top Holdings">
+    <SectionCard
+      title="Top Holdings"
+      headerRight={
+        concentration && (
+          <span className="text-xs text-muted-foreground font-mono">
+            Top 10: {concentration.top10Weight.toFixed(1)}% · HHI {concentration.hhi.toFixed(0)}
+            {concentration.estimated && ' (est.)'}
+          </span>
+        )
+      }
+    >
       <div className="overflow-x-auto">
         <table className="w-full text-sm">
           <thead>
@@ -1,13 +1,22 @@
 'use client';
 
 import { memo } from 'react';
-import { Info, ExternalLink } from 'lucide-react';
+import { Info, ExternalLink, ArrowUp, ArrowDown } from 'lucide-react';
 import { Popover, PopoverContent, PopoverTrigger } from '@/components/ui/popover';
 import { PercentileBar } from './percentile-bar';
 import { formatMetricValue, type MetricFormat } from '@/lib/formatters';
+import { NOT_MEANINGFUL_DISPLAY } from '@/lib/metric-helpers';
+import type { MarginDirection } from '@recon/shared';
 
 export type { MetricFormat };
 
+/** Change vs the prior period, shown as an arrow next to the value */
+export interface MetricTrend {
+  direction: MarginDirection;
+  /** Change in basis points */
+  deltaBps: number;
+}
+
 export interface Metric {
   /** Unique key for React */
   key: string;
@@ -27,6 +36,10 @@ export interface Metric {
   info?: string;
   /** Optional learn more URL */
   learnMoreUrl?: string;
+  /** Value exists but isn't meaningful (e.g., growth off a loss); shown as N/M */
+  notMeaningful?: boolean;
+  /** Optional prior-period trend (e.g., margin expansion) */
+  trend?: MetricTrend;
 }
 
 export interface MetricRowProps {
@@ -48,6 +61,24 @@ export interface MetricRowProps {
   learnMoreUrl?: string;
   /** Compact mode with reduced padding */
   compact?: boolean;
+  /** Show N/M instead of the value and percentile */
+  notMeaningful?: boolean;
+  /** Prior-period trend shown as an up/down arrow */
+  trend?: MetricTrend;
+}
+
+function TrendArrow({ trend }: { trend: MetricTrend }) {
+  if (trend.direction === 'flat') return null;
+
+  const isExpanding = trend.direction === 'expanding';
+  const Icon = isExpanding ? ArrowUp : ArrowDown;
+  const description = `${isExpanding ? '+' : ''}${trend.deltaBps.toFixed(0)} bps vs prior year`;
+
+  return (
+    <span className="inline-flex ml-1 align-text-bottom" title={description} aria-label={description}>
+      <Icon className={`h-3.5 w-3.5 ${isExpanding ? 'text-success' : 'text-destructive'}`} />
+    </span>
+  );
 }
 
 /**
@@ -64,6 +95,8 @@ function MetricRowComponent({
   info,
   learnMoreUrl,
   compact = false,
+  notMeaningful = false,
+  trend,
 }: MetricRowProps) {
   const cellPadding = compact ? 'py-2' : 'py-3';
 
@@ -106,8 +139,9 @@ function MetricRowComponent({
       {/* Stock value column */}
       <td className={`${cellPadding} px-4 text-right`}>
         <span className="font-mono text-sm text-foreground">
-          {formatMetricValue(value, format)}
+          {notMeaningful ? NOT_MEANINGFUL_DISPLAY : formatMetricValue(value, format)}
         </span>
+        {trend && !notMeaningful && <TrendArrow trend={trend} />}
       </td>
 
       {/* Industry average column */}
@@ -120,7 +154,7 @@ function MetricRowComponent({
       {/* Percentile column */}
       <td className={`${cellPadding} pl-4`}>
         <PercentileBar
-          percentile={percentile}
+          percentile={notMeaningful ? null : percentile}
           higherIsBetter={higherIsBetter}
           showLabel={true}
         />
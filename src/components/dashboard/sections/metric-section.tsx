@@ -82,6 +82,8 @@ export const MetricSection = memo(function MetricSection({
                 higherIsBetter={metric.higherIsBetter}
                 info={metric.info}
                 learnMoreUrl={metric.learnMoreUrl}
+                notMeaningful={metric.notMeaningful}
+                trend={metric.trend}
               />
             ))}
           </tbody>
@@ -19,7 +19,9 @@ export const PerformanceSection = memo(function PerformanceSection({ data }: Per
     { label: '1Y', value: performance.year1Change },
   ];
 
-  const rangePercent = ((quote.price - quote.fiftyTwoWeekLow) / (quote.fiftyTwoWeekHigh - quote.fiftyTwoWeekLow)) * 100;
+  const rangeWidth = quote.fiftyTwoWeekHigh - quote.fiftyTwoWeekLow;
+  const rangePercent = performance.rangePosition
+    ?? (rangeWidth > 0 ? ((quote.price - quote.fiftyTwoWeekLow) / rangeWidth) * 100 : 50);
 
   return (
     <SectionCard title="Performance">
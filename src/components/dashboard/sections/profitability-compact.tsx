@@ -2,7 +2,7 @@
 
 import { memo } from 'react';
 import { CollapsibleMetricSection, type Metric } from './collapsible-metric-section';
-import { toMetric } from '@/lib/metric-helpers';
+import { toMetric, marginTrendFor } from '@/lib/metric-helpers';
 import type { StockDetailResponse } from '@recon/shared';
 
 interface ProfitabilityCompactProps {
@@ -11,7 +11,7 @@ interface ProfitabilityCompactProps {
 }
 
 function ProfitabilityCompactComponent({ data, defaultOpen = false }: ProfitabilityCompactProps) {
-  const { company, profitability } = data;
+  const { company, profitability, financials } = data;
 
   if (!profitability) return null;
 
@@ -22,6 +22,7 @@ function ProfitabilityCompactComponent({ data, defaultOpen = false }: Profitabil
       toMetric('grossMargin', 'Gross Margin', profitability.grossMargin, {
         format: 'percent',
         higherIsBetter: true,
+        trend: marginTrendFor(financials?.marginTrend, 'gross'),
         info: 'Percentage of revenue remaining after deducting cost of goods sold.',
       })
     );
@@ -31,6 +32,7 @@ function ProfitabilityCompactComponent({ data, defaultOpen = false }: Profitabil
     toMetric('operatingMargin', 'Operating Margin', profitability.operatingMargin, {
       format: 'percent',
       higherIsBetter: true,
+      trend: marginTrendFor(financials?.marginTrend, 'operating'),
       info: 'Percentage of revenue remaining after covering operating expenses.',
     })
   );
@@ -1,7 +1,7 @@
 'use client';
 
 import { MetricSection, type Metric } from './metric-section';
-import { toMetric, buildShareText } from '@/lib/metric-helpers';
+import { toMetric, buildShareText, marginTrendFor } from '@/lib/metric-helpers';
 import type { StockDetailResponse } from '@recon/shared';
 
 interface ProfitabilitySectionProps {
@@ -9,7 +9,7 @@ interface ProfitabilitySectionProps {
 }
 
 export function ProfitabilitySection({ data }: ProfitabilitySectionProps) {
-  const { company, profitability } = data;
+  const { company, profitability, financials } = data;
   if (!profitability) return null;
 
   // Build metrics array - order determines display priority
@@ -22,6 +22,7 @@ export function ProfitabilitySection({ data }: ProfitabilitySectionProps) {
       toMetric('grossMargin', 'Gross Margin', profitability.grossMargin, {
         format: 'percent',
         higherIsBetter: true,
+        trend: marginTrendFor(financials?.marginTrend, 'gross'),
         info: 'Gross Margin shows the percentage of revenue remaining after deducting cost of goods sold. Higher margins indicate better production efficiency.',
         learnMoreUrl: 'https://www.investopedia.com/terms/g/grossmargin.asp',
       })
@@ -32,6 +33,7 @@ export function ProfitabilitySection({ data }: ProfitabilitySectionProps) {
     toMetric('operatingMargin', 'Operating Margin', profitability.operatingMargin, {
       format: 'percent',
       higherIsBetter: true,
+      trend: marginTrendFor(financials?.marginTrend, 'operating'),
       info: 'Operating Margin shows what percentage of revenue remains after covering operating expenses. Higher margins indicate better operational efficiency.',
       learnMoreUrl: 'https://www.investopedia.com/terms/o/operatingmargin.asp',
     })
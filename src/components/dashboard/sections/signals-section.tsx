@@ -4,7 +4,7 @@ import { memo, useMemo } from 'react';
 import { CheckCircle2, AlertTriangle, TrendingDown, Target } from 'lucide-react';
 import { SectionCard } from './section-card';
 import { Badge } from '@/components/ui/badge';
-import type { StockDetailResponse } from '@recon/shared';
+import type { SignalStrength, StockDetailResponse } from '@recon/shared';
 
 interface SignalsSectionProps {
   data: StockDetailResponse;
@@ -113,6 +113,12 @@ const getSignalIcon = (type: string) => {
   }
 };
 
+const STRENGTH_RANK: Record<SignalStrength, number> = { strong: 3, moderate: 2, weak: 1 };
+
+function strengthRank(strength: SignalStrength | undefined): number {
+  return strength ? STRENGTH_RANK[strength] : 0;
+}
+
 function SignalsSectionComponent({ data }: SignalsSectionProps) {
   const { signals: rawSignals, performance, quote, analystEstimates } = data;
 
@@ -144,11 +150,14 @@ function SignalsSectionComponent({ data }: SignalsSectionProps) {
       totalBullish: byType.bullish.length + techBullish,
       totalBearish: byType.bearish.length + techBearish,
       totalWarning: byType.warning.length + volWarning,
+      // Stable sort keeps bullish/warning/bearish order within the same strength
       topSignals: [
         ...byType.bullish,
         ...byType.warning,
         ...byType.bearish,
-      ].slice(0, 3),
+      ]
+        .sort((a, b) => strengthRank(b.strength) - strengthRank(a.strength))
+        .slice(0, 3),
     };
   }, [rawSignals, indicators]);
 
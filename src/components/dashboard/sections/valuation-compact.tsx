@@ -53,9 +53,18 @@ function ValuationCompactComponent({ data, defaultOpen = false }: ValuationCompa
     }),
   ];
 
+  const sectorDiscount = valuation.sectorDiscount;
+  let summary: string | undefined;
+  if (sectorDiscount != null) {
+    summary = Math.round(sectorDiscount) === 0
+      ? 'In line with sector'
+      : `Trading at a ${Math.abs(sectorDiscount).toFixed(0)}% ${sectorDiscount > 0 ? 'discount' : 'premium'} to sector`;
+  }
+
   return (
     <CollapsibleMetricSection
       title="Valuation"
+      summary={summary}
       ticker={company.ticker}
       metrics={metrics}
       deepDiveUrl={`/stock/${company.ticker}/valuation`}
@@ -4,6 +4,8 @@ import dynamic from 'next/dynamic';
 import { useRouter } from 'next/navigation';
 import { AlertTriangle, Loader2 } from 'lucide-react';
 import { useStock } from '@/hooks/use-stock';
+import { ApiError } from '@/lib/api';
+import { normalizeTicker } from '@/lib/utils';
 import { Button } from '@/components/ui/button';
 import { Skeleton } from '@/components/ui/skeleton';
 import { StockDetailResponse } from '@recon/shared';
@@ -113,17 +115,27 @@ export function StockDashboard({ ticker, initialData }: StockDashboardProps) {
   }
 
   if (error || !data) {
+    const delisted = error instanceof ApiError && error.code === 'TICKER_DELISTED';
+
     return (
       <div className="flex flex-col items-center justify-center py-24 animate-in fade-in zoom-in-95 duration-300">
         <div className="p-4 rounded-full bg-destructive/10 mb-4">
           <AlertTriangle className="h-8 w-8 text-destructive" />
         </div>
-        <h2 className="text-xl font-semibold">Unable to load stock data</h2>
+        <h2 className="text-xl font-semibold">
+          {delisted ? `${normalizeTicker(ticker)} is no longer listed` : 'Unable to load stock data'}
+        </h2>
         <p className="mt-2 text-muted-foreground text-center max-w-md mx-auto">
           {error instanceof Error
             ? error.message
             : `We couldn't retrieve data for ${ticker}. It might be delisted or invalid.`}
         </p>
+        {delisted && error.details?.lastTradeDate && (
+          <p className="mt-1 text-sm text-muted-foreground font-mono">
+            Last traded {error.details.lastTradeDate}
+            {error.details.lastPrice && ` at $${error.details.lastPrice}`}
+          </p>
+        )}
         <Button
           variant="outline"
           onClick={() => router.push('/')}
@@ -148,6 +160,21 @@ export function StockDashboard({ ticker, initialData }: StockDashboardProps) {
         {/* Header with price & performance */}
         <HeaderSection data={data} />
 
+        {/* Partial response - provider calls failed or implausible values were dropped */}
+        {(data.degraded || (data.warnings && data.warnings.length > 0)) && (
+          <div className="flex items-start gap-2 p-3 rounded-xl border bg-warning/5 border-warning/30 text-sm">
+            <AlertTriangle className="h-4 w-4 mt-0.5 shrink-0 text-warning" />
+            <div>
+              <p className="font-medium">
+                {data.degraded ? "Some data couldn't be loaded" : 'Some provider values looked implausible and are hidden'}
+              </p>
+              {data.warnings && data.warnings.length > 0 && (
+                <p className="text-muted-foreground">{data.warnings.join(' • ')}</p>
+              )}
+            </div>
+          </div>
+        )}
+
         {/* CruxAI Insights - Position Summary and News Sentiment side by side */}
         <div className="grid grid-cols-1 md:grid-cols-2 gap-2">
           <CruxAIInsight ticker={ticker} section="position-summary" />
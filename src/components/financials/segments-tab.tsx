@@ -2,24 +2,93 @@
 
 import { memo } from 'react';
 import { PieChart } from 'lucide-react';
+import type { RevenueSegment, RevenueSegments } from '@recon/shared';
+import { formatCompactCurrency } from '@/lib/utils';
 
 interface SegmentsTabProps {
   ticker: string;
+  segments?: RevenueSegments;
 }
 
-export const SegmentsTab = memo(function SegmentsTab({ ticker }: SegmentsTabProps) {
+const SegmentBreakdown = memo(function SegmentBreakdown({
+  title,
+  fiscalYear,
+  segments,
+}: {
+  title: string;
+  fiscalYear: number;
+  segments: RevenueSegment[];
+}) {
+  if (segments.length === 0) return null;
+
+  const maxPercent = Math.max(...segments.map((s) => s.percentOfTotal));
+
+  return (
+    <div className="bg-card/50 rounded-xl p-5 border border-border/50 shadow-sm">
+      <h4 className="text-sm font-semibold mb-4">{title} (FY {fiscalYear})</h4>
+      <div className="space-y-3">
+        {segments.map((segment, index) => (
+          <div key={`${index}-${segment.name}`} className="flex items-center gap-4">
+            <div className="w-40 text-sm text-muted-foreground text-right font-medium truncate" title={segment.name}>
+              {segment.name}
+            </div>
+            <div className="flex-1 h-7 bg-muted/40 rounded-lg relative overflow-hidden">
+              <div
+                className="h-full rounded-lg bg-primary transition-all duration-500"
+                style={{
+                  width: `${Math.max(maxPercent > 0 ? (segment.percentOfTotal / maxPercent) * 100 : 0, 2)}%`,
+                }}
+              />
+            </div>
+            <div className="w-24 text-sm font-mono text-right tabular-nums">
+              {formatCompactCurrency(segment.revenue)}
+            </div>
+            <div className="w-14 text-sm font-mono text-right tabular-nums text-muted-foreground">
+              {segment.percentOfTotal.toFixed(1)}%
+            </div>
+          </div>
+        ))}
+      </div>
+    </div>
+  );
+});
+
+function SegmentsEmptyState({ message }: { message: string }) {
   return (
     <div className="py-12 text-center">
       <div className="inline-flex items-center justify-center w-16 h-16 rounded-2xl bg-muted/50 mb-4">
         <PieChart className="h-8 w-8 text-muted-foreground" />
       </div>
       <h3 className="text-lg font-semibold mb-2">Revenue Segments</h3>
-      <p className="text-muted-foreground max-w-md mx-auto">
-        Revenue breakdown by business segment and geography for {ticker.toUpperCase()} will be available soon.
-      </p>
+      <p className="text-muted-foreground max-w-md mx-auto">{message}</p>
       <p className="text-xs text-muted-foreground mt-4">
         Segment data sourced from 10-K annual filings
       </p>
     </div>
   );
+}
+
+export const SegmentsTab = memo(function SegmentsTab({ ticker, segments }: SegmentsTabProps) {
+  // Absent means the API has no segment data; empty lists mean the company reports no segmentation
+  if (!segments) {
+    return <SegmentsEmptyState message={`Segment data is unavailable for ${ticker.toUpperCase()}.`} />;
+  }
+
+  if (segments.product.length === 0 && segments.geographic.length === 0) {
+    return (
+      <SegmentsEmptyState
+        message={`${ticker.toUpperCase()} does not report a revenue breakdown by business segment or geography.`}
+      />
+    );
+  }
+
+  return (
+    <div className="space-y-6">
+      <SegmentBreakdown title="Revenue by Product" fiscalYear={segments.fiscalYear} segments={segments.product} />
+      <SegmentBreakdown title="Revenue by Geography" fiscalYear={segments.fiscalYear} segments={segments.geographic} />
+      <p className="text-xs text-muted-foreground">
+        Segment data sourced from 10-K annual filings
+      </p>
+    </div>
+  );
 });
@@ -7,7 +7,7 @@ import { Input } from '@/components/ui/input';
 import { Button } from '@/components/ui/button';
 import { Badge } from '@/components/ui/badge';
 import { useSearch } from '@/hooks/use-search';
-import { cn } from '@/lib/utils';
+import { cn, normalizeTicker } from '@/lib/utils';
 
 interface TickerSearchProps {
   size?: 'default' | 'lg';
@@ -63,12 +63,12 @@ export function TickerSearch({
 
   const handleSubmit = useCallback(
     (ticker?: string) => {
-      const target = ticker || query;
-      if (target.trim()) {
+      const target = normalizeTicker(ticker || query);
+      if (target) {
         if (onSelect) {
-          onSelect(target.toUpperCase());
+          onSelect(target);
         } else {
-          router.push(`/?ticker=${target.toUpperCase()}`);
+          router.push(`/?ticker=${target}`);
         }
         setIsOpen(false);
         setQuery('');
@@ -24,6 +24,7 @@ const COLUMN_LABELS: { key: ColumnKey; label: string }[] = [
   { key: 'from52wHigh', label: '% From 52W High' },
   { key: 'chart1Y', label: 'Chart 1Y' },
   { key: 'rsRank', label: 'RS Rank' },
+  { key: 'rsBench', label: 'RS vs SPY' },
   { key: 'sma', label: 'SMA Status' },
 ];
 
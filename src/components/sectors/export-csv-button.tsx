@@ -0,0 +1,49 @@
+'use client';
+
+import { useEffect, useState } from 'react';
+import { Download } from 'lucide-react';
+import { fetchSectorOverviewCsv, type SectorSortField } from '@/lib/api';
+import { downloadBlob } from '@/lib/utils';
+
+interface ExportCsvButtonProps {
+  sector: string;
+  sort: SectorSortField;
+  limit: number;
+}
+
+export function ExportCsvButton({ sector, sort, limit }: ExportCsvButtonProps) {
+  const [isExporting, setIsExporting] = useState(false);
+  const [hasFailed, setHasFailed] = useState(false);
+
+  // A failure only describes the export that was attempted; clear it once the view changes
+  useEffect(() => {
+    setHasFailed(false);
+  }, [sector, sort]);
+
+  const handleExport = async () => {
+    setIsExporting(true);
+    setHasFailed(false);
+    try {
+      const { blob, filename } = await fetchSectorOverviewCsv(sector, sort, limit);
+      const slug = sector.toLowerCase().replace(/\s+/g, '-');
+      downloadBlob(blob, filename ?? `${slug}-overview.csv`);
+    } catch {
+      setHasFailed(true);
+    } finally {
+      setIsExporting(false);
+    }
+  };
+
+  return (
+    <button
+      type="button"
+      onClick={handleExport}
+      disabled={isExporting}
+      aria-label="Export sector overview as CSV"
+      className="flex items-center gap-1.5 h-9 rounded-md border border-input bg-background px-3 text-sm text-muted-foreground hover:text-foreground transition-colors disabled:opacity-50"
+    >
+      <Download className="h-4 w-4" />
+      {hasFailed ? 'Export failed' : 'CSV'}
+    </button>
+  );
+}
@@ -35,6 +35,7 @@ const COLUMNS: ColumnDef[] = [
   { key: 'from52wHigh', label: '% 52W Hi' },
   { key: 'chart1Y', label: 'Chart 1Y', hiddenOnMobile: true },
   { key: 'rsRank', label: 'RS', hiddenOnMobile: true },
+  { key: 'rsBench', label: 'vs SPY', hiddenOnMobile: true },
   { key: 'sma', label: 'SMA', hiddenOnMobile: true },
 ];
 
@@ -166,6 +167,15 @@ const HeatmapRow = memo(function HeatmapRow({
                 className={`text-sm ${col.hiddenOnMobile ? 'hidden md:table-cell' : ''}`}
               />
             );
+          case 'rsBench':
+            return (
+              <HeatmapCell
+                key={col.key}
+                value={stock.relativeToBenchmark ?? null}
+                scale="performance"
+                className={`text-sm ${col.hiddenOnMobile ? 'hidden md:table-cell' : ''}`}
+              />
+            );
           case 'sma':
             return (
               <td
@@ -6,6 +6,7 @@ import { useSectors, useSectorOverview } from '@/hooks/use-sector-overview';
 import type { SectorSortField } from '@/lib/api';
 import { useColumnVisibility } from './use-column-visibility';
 import { ColumnToggle } from './column-toggle';
+import { ExportCsvButton } from './export-csv-button';
 import { ShareButton } from '@/components/ui/share-button';
 import { SummaryBar } from './summary-bar';
 import { HeatmapTable, HeatmapTableSkeleton } from './heatmap-table';
@@ -21,6 +22,7 @@ const SORT_OPTIONS: { value: SectorSortField; label: string }[] = [
   { value: 'marketcap', label: 'Market Cap' },
   { value: 'ps', label: 'P/S Ratio' },
   { value: 'pe', label: 'P/E Ratio' },
+  { value: 'rsbench', label: 'RS vs SPY' },
 ];
 
 export function SectorHeatmap() {
@@ -97,6 +99,7 @@ export function SectorHeatmap() {
             text={`${sector} sector overview on Cruxit`}
             url={`https://cruxit.finance/sectors?sector=${encodeURIComponent(sector)}`}
           />
+          <ExportCsvButton sector={sector} sort={sort} limit={DEFAULT_LIMIT} />
           <ColumnToggle
             visibleColumns={visibleColumns}
             onToggle={toggleColumn}
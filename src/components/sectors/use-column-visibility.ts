@@ -13,13 +13,14 @@ export type ColumnKey =
   | 'from52wHigh'
   | 'chart1Y'
   | 'rsRank'
+  | 'rsBench'
   | 'sma';
 
 const STORAGE_KEY = 'sector-columns';
 
 const DEFAULT_COLUMNS: ColumnKey[] = [
   'name', 'price', 'marketCap', 'ps', 'pe', 'roic',
-  'ytd', '1y', 'from52wHigh', 'chart1Y', 'rsRank', 'sma',
+  'ytd', '1y', 'from52wHigh', 'chart1Y', 'rsRank', 'rsBench', 'sma',
 ];
 
 function loadColumns(): Set<ColumnKey> {
@@ -2,6 +2,7 @@
 
 import { memo } from 'react';
 import { formatCurrency } from '@/lib/formatters';
+import { useInsiderTrades } from '@/hooks/use-insider-trades';
 import type { StockDetailResponse, InsiderTrade } from '@recon/shared';
 import { SocialLinks } from './social-links';
 
@@ -13,6 +14,12 @@ interface InsiderTradeRowProps {
   trade: InsiderTrade;
 }
 
+interface RecentTransactionsProps {
+  ticker: string;
+  /** Trades embedded in stock detail, shown until the paged history loads */
+  initialTrades: InsiderTrade[];
+}
+
 function formatTradeDate(dateStr: string): string {
   if (!dateStr) return '';
   const date = new Date(dateStr);
@@ -27,7 +34,9 @@ const InsiderTradeRow = memo(function InsiderTradeRow({
   trade,
 }: InsiderTradeRowProps) {
   const isBuy = trade.tradeType === 'buy';
-  const colorClass = isBuy ? 'text-success' : 'text-destructive';
+  // Awards, exercises and gifts aren't buy/sell decisions, so show them neutrally
+  const isOpenMarket = !trade.category || trade.category === 'open-market';
+  const colorClass = !isOpenMarket ? 'text-muted-foreground' : isBuy ? 'text-success' : 'text-destructive';
 
   return (
     <div className="flex items-center justify-between py-3 border-b border-border/30 last:border-0">
@@ -49,10 +58,12 @@ const InsiderTradeRow = memo(function InsiderTradeRow({
       <div className="flex items-center gap-3 flex-shrink-0">
         <span
           className={`text-xs font-medium uppercase px-2 py-0.5 rounded ${
-            isBuy ? 'bg-success/10 text-success' : 'bg-destructive/10 text-destructive'
+            !isOpenMarket
+              ? 'bg-muted text-muted-foreground'
+              : isBuy ? 'bg-success/10 text-success' : 'bg-destructive/10 text-destructive'
           }`}
         >
-          {trade.tradeType}
+          {isOpenMarket ? trade.tradeType : trade.category}
         </span>
         <span className={`font-mono font-medium ${colorClass}`}>
           {formatCurrency(trade.value)}
@@ -62,6 +73,49 @@ const InsiderTradeRow = memo(function InsiderTradeRow({
   );
 });
 
+const RecentTransactions = memo(function RecentTransactions({
+  ticker,
+  initialTrades,
+}: RecentTransactionsProps) {
+  const { data, error, fetchNextPage, hasNextPage, isFetchingNextPage } = useInsiderTrades(ticker);
+
+  const pagedTrades = data?.pages.flatMap((page) => page.trades);
+  const trades = (pagedTrades ?? initialTrades).filter((trade) => trade.value > 0);
+
+  if (trades.length === 0) return null;
+
+  return (
+    <div>
+      <h3 className="text-sm font-semibold mb-4">Recent Transactions</h3>
+      <div className="bg-card/30 rounded-lg p-3">
+        {trades.map((trade, idx) => (
+          <InsiderTradeRow
+            key={`${trade.tradeDate}-${trade.insiderName}-${idx}`}
+            trade={trade}
+          />
+        ))}
+      </div>
+      {error && (
+        <div className="text-xs text-destructive text-center mt-2">
+          Could not load older transactions
+        </div>
+      )}
+      {hasNextPage && (
+        <div className="text-center mt-3">
+          <button
+            type="button"
+            onClick={() => fetchNextPage()}
+            disabled={isFetchingNextPage}
+            className="h-8 rounded-md border border-input bg-background px-3 text-xs text-muted-foreground hover:text-foreground transition-colors disabled:opacity-50"
+          >
+            {isFetchingNextPage ? 'Loading...' : 'Load more'}
+          </button>
+        </div>
+      )}
+    </div>
+  );
+});
+
 export const InsidersTab = memo(function InsidersTab({
   data,
 }: InsidersTabProps) {
@@ -117,28 +171,7 @@ export const InsidersTab = memo(function InsidersTab({
         )}
       </div>
 
-      {/* Recent Transactions */}
-      {trades.length > 0 && (
-        <div>
-          <h3 className="text-sm font-semibold mb-4">Recent Transactions</h3>
-          <div className="bg-card/30 rounded-lg p-3">
-            {trades
-              .filter((trade) => trade.value > 0)
-              .slice(0, 10)
-              .map((trade, idx) => (
-                <InsiderTradeRow
-                  key={`${trade.tradeDate}-${trade.insiderName}-${idx}`}
-                  trade={trade}
-                />
-              ))}
-          </div>
-          {trades.filter((t) => t.value > 0).length > 10 && (
-            <div className="text-xs text-muted-foreground text-center mt-2">
-              Showing 10 of {trades.filter((t) => t.value > 0).length} transactions
-            </div>
-          )}
-        </div>
-      )}
+      <RecentTransactions ticker={data.company.ticker} initialTrades={trades} />
 
       <SocialLinks />
     </div>
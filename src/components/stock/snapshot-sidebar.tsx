@@ -4,6 +4,7 @@ import Link from 'next/link';
 import { ChevronRight } from 'lucide-react';
 import type { StockDetailResponse } from '@recon/shared';
 import { formatPercent, formatRatio, formatCompact, formatCurrency, formatDecimal } from '@/lib/formatters';
+import { NOT_MEANINGFUL_DISPLAY, isNotMeaningful, meaningfulValue } from '@/lib/metric-helpers';
 
 interface SnapshotSidebarProps {
   data: StockDetailResponse;
@@ -145,8 +146,12 @@ export function SnapshotSidebar({ data }: SnapshotSidebarProps) {
           />
           <DataRow
             label="EPS YoY"
-            value={formatPercent(growth?.epsGrowthYoY.value, { showSign: true, decimals: 1 })}
-            color={getPercentColor(growth?.epsGrowthYoY.value)}
+            value={
+              isNotMeaningful(growth?.epsGrowthYoY)
+                ? NOT_MEANINGFUL_DISPLAY
+                : formatPercent(growth?.epsGrowthYoY.value, { showSign: true, decimals: 1 })
+            }
+            color={getPercentColor(meaningfulValue(growth?.epsGrowthYoY))}
           />
           {growth?.freeCashFlowTTM && (
             <DataRow
@@ -183,6 +188,17 @@ export function SnapshotSidebar({ data }: SnapshotSidebarProps) {
               label="Analysts"
               value={`${analystEstimates.analystCount}`}
             />
+            {analystEstimates.beatRate != null && (
+              <DataRow
+                label="Beat Rate"
+                value={formatPercent(analystEstimates.beatRate, { decimals: 0 })}
+                color={
+                  analystEstimates.beatRate >= 75 ? 'text-success' :
+                  analystEstimates.beatRate < 50 ? 'text-destructive' :
+                  'text-foreground'
+                }
+              />
+            )}
           </Section>
         )}
 
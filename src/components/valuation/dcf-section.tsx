@@ -9,11 +9,12 @@ import {
   Shield,
   Wrench,
   Rocket,
+  Percent,
 } from 'lucide-react';
 import { SectionCard } from '@/components/dashboard/sections/section-card';
 import { cn } from '@/lib/utils';
 import { formatPrice, formatPercent, formatCompact, formatDecimal } from '@/lib/formatters';
-import type { ValuationDeepDive } from '@recon/shared';
+import type { ValuationDeepDive, WACCBreakdown } from '@recon/shared';
 
 interface DCFSectionProps {
   data: ValuationDeepDive;
@@ -301,6 +302,11 @@ function DCFSectionComponent({ data }: DCFSectionProps) {
           </div>
         )}
 
+        {/* Discount Rate / WACC Breakdown */}
+        {dcfAnalysis?.discountRate != null && (
+          <DiscountRatePanel discountRate={dcfAnalysis.discountRate} wacc={dcfAnalysis.wacc ?? null} />
+        )}
+
         {/* Insight Box */}
         {insight && (
           <div
@@ -335,6 +341,78 @@ function DCFSectionComponent({ data }: DCFSectionProps) {
   );
 }
 
+/** Rates in WACCBreakdown are already percentages (e.g., 4.2 = 4.2%). */
+function formatRate(value: number): string {
+  return `${value.toFixed(1)}%`;
+}
+
+/** Weights are fractions of total capital (e.g., 0.85 = 85%). */
+function formatWeight(value: number): string {
+  return `${(value * 100).toFixed(0)}%`;
+}
+
+/**
+ * Discount rate used by the DCF, with the CAPM/WACC components behind it.
+ */
+function DiscountRatePanel({
+  discountRate,
+  wacc,
+}: {
+  discountRate: number;
+  wacc: WACCBreakdown | null;
+}) {
+  return (
+    <div className="p-4 rounded-lg bg-muted/30 border border-border/30">
+      <div className="flex items-center justify-between mb-4">
+        <h4 className="text-sm font-semibold uppercase tracking-wider flex items-center gap-2">
+          <Percent className="h-4 w-4" />
+          Discount Rate
+        </h4>
+        <span className="text-lg font-bold font-mono">{formatRate(discountRate)}</span>
+      </div>
+
+      {wacc ? (
+        <div className="grid grid-cols-2 md:grid-cols-4 gap-2 text-center">
+          <div className="p-2 rounded bg-background/50">
+            <div className="text-[10px] text-muted-foreground uppercase tracking-wider mb-1">
+              Cost of Equity
+            </div>
+            <div className="text-sm font-mono">{formatRate(wacc.costOfEquity)}</div>
+            <div className="text-[10px] text-muted-foreground font-mono mt-1">
+              {formatRate(wacc.riskFreeRate)} + {formatDecimal(wacc.beta)}β × {formatRate(wacc.equityRiskPremium)}
+            </div>
+          </div>
+          <div className="p-2 rounded bg-background/50">
+            <div className="text-[10px] text-muted-foreground uppercase tracking-wider mb-1">
+              Cost of Debt
+            </div>
+            <div className="text-sm font-mono">{formatRate(wacc.costOfDebt)}</div>
+            <div className="text-[10px] text-muted-foreground font-mono mt-1">
+              pre-tax, {formatRate(wacc.taxRate)} tax
+            </div>
+          </div>
+          <div className="p-2 rounded bg-background/50">
+            <div className="text-[10px] text-muted-foreground uppercase tracking-wider mb-1">
+              Equity Weight
+            </div>
+            <div className="text-sm font-mono">{formatWeight(wacc.equityWeight)}</div>
+          </div>
+          <div className="p-2 rounded bg-background/50">
+            <div className="text-[10px] text-muted-foreground uppercase tracking-wider mb-1">
+              Debt Weight
+            </div>
+            <div className="text-sm font-mono">{formatWeight(wacc.debtWeight)}</div>
+          </div>
+        </div>
+      ) : (
+        <p className="text-xs text-muted-foreground">
+          WACC could not be computed for this company, so the default discount rate is used.
+        </p>
+      )}
+    </div>
+  );
+}
+
 /**
  * Visual price scale showing current price position relative to fair value and MoS levels.
  */
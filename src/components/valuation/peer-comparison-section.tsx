@@ -318,6 +318,7 @@ function PeerComparisonSectionComponent({ data }: PeerComparisonSectionProps) {
   }
 
   const { peers, medians, insight } = sectorContext;
+  const peerCount = sectorContext.peerCount ?? peers.length;
   const metricButtons: MetricKey[] = ['evToEbitda', 'pe', 'ps', 'peg'];
 
   return (
@@ -360,7 +361,7 @@ function PeerComparisonSectionComponent({ data }: PeerComparisonSectionProps) {
         {/* Comparison table */}
         <div className="mt-6">
           <h4 className="text-sm font-medium text-muted-foreground mb-3">
-            Full Comparison ({peers.length} peers)
+            Full Comparison ({peerCount} {peerCount === 1 ? 'peer' : 'peers'})
           </h4>
           <ComparisonTable
             data={peers}
@@ -8,6 +8,7 @@ import {
   type BalanceSheetResponse,
   type CashFlowResponse,
 } from '@/lib/api';
+import { normalizeTicker } from '@/lib/utils';
 
 const FINANCIALS_STALE_TIME = 5 * 60 * 1000; // 5 minutes
 
@@ -21,14 +22,16 @@ export function useIncomeStatements(
   ticker: string,
   options?: UseFinancialsOptions
 ) {
+  const normalizedTicker = normalizeTicker(ticker);
+
   return useQuery<IncomeStatementResponse>({
-    queryKey: ['income-statements', ticker.toUpperCase(), options?.period, options?.limit],
-    queryFn: () => fetchIncomeStatements(ticker, {
+    queryKey: ['income-statements', normalizedTicker, options?.period, options?.limit],
+    queryFn: () => fetchIncomeStatements(normalizedTicker, {
       period: options?.period,
       limit: options?.limit,
     }),
     staleTime: FINANCIALS_STALE_TIME,
-    enabled: options?.enabled ?? Boolean(ticker),
+    enabled: (options?.enabled ?? true) && Boolean(normalizedTicker),
   });
 }
 
@@ -36,14 +39,16 @@ export function useBalanceSheets(
   ticker: string,
   options?: UseFinancialsOptions
 ) {
+  const normalizedTicker = normalizeTicker(ticker);
+
   return useQuery<BalanceSheetResponse>({
-    queryKey: ['balance-sheets', ticker.toUpperCase(), options?.period, options?.limit],
-    queryFn: () => fetchBalanceSheets(ticker, {
+    queryKey: ['balance-sheets', normalizedTicker, options?.period, options?.limit],
+    queryFn: () => fetchBalanceSheets(normalizedTicker, {
       period: options?.period,
       limit: options?.limit,
     }),
     staleTime: FINANCIALS_STALE_TIME,
-    enabled: options?.enabled ?? Boolean(ticker),
+    enabled: (options?.enabled ?? true) && Boolean(normalizedTicker),
   });
 }
 
@@ -51,13 +56,15 @@ export function useCashFlowStatements(
   ticker: string,
   options?: UseFinancialsOptions
 ) {
+  const normalizedTicker = normalizeTicker(ticker);
+
   return useQuery<CashFlowResponse>({
-    queryKey: ['cash-flow-statements', ticker.toUpperCase(), options?.period, options?.limit],
-    queryFn: () => fetchCashFlowStatements(ticker, {
+    queryKey: ['cash-flow-statements', normalizedTicker, options?.period, options?.limit],
+    queryFn: () => fetchCashFlowStatements(normalizedTicker, {
       period: options?.period,
       limit: options?.limit,
     }),
     staleTime: FINANCIALS_STALE_TIME,
-    enabled: options?.enabled ?? Boolean(ticker),
+    enabled: (options?.enabled ?? true) && Boolean(normalizedTicker),
   });
 }
@@ -0,0 +1,19 @@
+import { useInfiniteQuery } from '@tanstack/react-query';
+import { fetchInsiderTrades } from '@/lib/api';
+import { STALE_TIME } from '@/lib/constants';
+import { normalizeTicker } from '@/lib/utils';
+
+const INSIDER_PAGE_SIZE = 25;
+
+export function useInsiderTrades(ticker: string) {
+  const normalizedTicker = normalizeTicker(ticker);
+
+  return useInfiniteQuery({
+    queryKey: ['insider-trades', normalizedTicker],
+    queryFn: ({ pageParam }) => fetchInsiderTrades(normalizedTicker, pageParam, INSIDER_PAGE_SIZE),
+    initialPageParam: undefined as string | undefined,
+    getNextPageParam: (lastPage) => lastPage.nextCursor ?? undefined,
+    staleTime: STALE_TIME,
+    enabled: Boolean(normalizedTicker),
+  });
+}
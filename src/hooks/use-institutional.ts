@@ -1,13 +1,16 @@
 import { useQuery } from '@tanstack/react-query';
 import { fetchInstitutionalDetail, InstitutionalDetail } from '@/lib/api';
 import { STALE_TIME } from '@/lib/constants';
+import { normalizeTicker } from '@/lib/utils';
 
 export function useInstitutionalDetail(ticker: string) {
+  const normalizedTicker = normalizeTicker(ticker);
+
   return useQuery<InstitutionalDetail>({
-    queryKey: ['institutional', ticker.toUpperCase()],
-    queryFn: () => fetchInstitutionalDetail(ticker),
+    queryKey: ['institutional', normalizedTicker],
+    queryFn: () => fetchInstitutionalDetail(normalizedTicker),
     staleTime: STALE_TIME,
-    enabled: Boolean(ticker),
+    enabled: Boolean(normalizedTicker),
     retry: (failureCount, error) => {
       // Don't retry on 404s
       if (error instanceof Error && 'status' in error && error.status === 404) {
@@ -0,0 +1,12 @@
+import { useQuery } from '@tanstack/react-query';
+import { fetchMovers, type MoverType, type MoversResponse } from '@/lib/api';
+
+const MOVERS_STALE_TIME = 60 * 1000; // 1 minute (intraday, matches API cache)
+
+export function useMovers(type: MoverType) {
+  return useQuery<MoversResponse>({
+    queryKey: ['movers', type],
+    queryFn: () => fetchMovers(type),
+    staleTime: MOVERS_STALE_TIME,
+  });
+}
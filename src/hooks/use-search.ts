@@ -2,11 +2,11 @@
 
 import { useState, useMemo, useEffect } from 'react';
 import { useQuery } from '@tanstack/react-query';
-import { searchTickers } from '@/lib/api';
+import { searchTickers, type SearchOptions } from '@/lib/api';
 import { debounce } from '@/lib/utils';
 import type { SearchResponse } from '@recon/shared';
 
-export function useSearch() {
+export function useSearch(options?: SearchOptions) {
   const [query, setQuery] = useState('');
   const [debouncedQuery, setDebouncedQuery] = useState('');
 
@@ -25,8 +25,8 @@ export function useSearch() {
   };
 
   const { data, isLoading, error } = useQuery<SearchResponse>({
-    queryKey: ['search', debouncedQuery],
-    queryFn: () => searchTickers(debouncedQuery),
+    queryKey: ['search', debouncedQuery, options],
+    queryFn: () => searchTickers(debouncedQuery, options),
     enabled: debouncedQuery.length >= 2, // Require 2 chars to reduce noise
     staleTime: 60 * 1000, // 1 minute cache
   });
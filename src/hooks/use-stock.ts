@@ -1,18 +1,32 @@
 import { useQuery } from '@tanstack/react-query';
-import { fetchStock } from '@/lib/api';
+import { fetchStock, type StockDetailOptions } from '@/lib/api';
 import { STALE_TIME } from '@/lib/constants';
+import { normalizeTicker } from '@/lib/utils';
 import type { StockDetailResponse } from '@recon/shared';
 
-export function useStock(ticker: string, initialData?: StockDetailResponse | null) {
+export function useStock(
+  ticker: string,
+  initialData?: StockDetailResponse | null,
+  options?: StockDetailOptions
+) {
+  const hasOptions = Boolean(
+    options?.period || options?.asOf || options?.financialPeriods || options?.priceDays
+  );
+
+  const normalizedTicker = normalizeTicker(ticker);
+
   return useQuery<StockDetailResponse>({
-    queryKey: ['stock', ticker.toUpperCase()],
-    queryFn: () => fetchStock(ticker),
+    // Default options share the ['stock', ticker] key with useStocks
+    queryKey: hasOptions
+      ? ['stock', normalizedTicker, options]
+      : ['stock', normalizedTicker],
+    queryFn: () => fetchStock(normalizedTicker, options),
     staleTime: STALE_TIME,
-    enabled: Boolean(ticker),
+    enabled: Boolean(normalizedTicker),
     initialData: initialData || undefined,
     retry: (failureCount, error) => {
-      // Don't retry on 404s
-      if (error instanceof Error && 'status' in error && error.status === 404) {
+      // Don't retry unknown (404) or delisted (410) tickers
+      if (error instanceof Error && 'status' in error && (error.status === 404 || error.status === 410)) {
         return false;
       }
       return failureCount < 2;
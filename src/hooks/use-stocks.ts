@@ -1,17 +1,18 @@
 import { useQueries } from '@tanstack/react-query';
 import { fetchStock, ApiError } from '@/lib/api';
 import { STALE_TIME } from '@/lib/constants';
+import { normalizeTicker } from '@/lib/utils';
 import type { StockDetailResponse } from '@recon/shared';
 
 export function useStocks(tickers: string[]) {
   const queries = useQueries({
-    queries: tickers.map((ticker) => ({
-      queryKey: ['stock', ticker.toUpperCase()],
+    queries: tickers.map(normalizeTicker).map((ticker) => ({
+      queryKey: ['stock', ticker],
       queryFn: () => fetchStock(ticker),
       staleTime: STALE_TIME,
       enabled: Boolean(ticker),
       retry: (failureCount: number, error: Error) => {
-        if (error instanceof ApiError && error.status === 404) {
+        if (error instanceof ApiError && (error.status === 404 || error.status === 410)) {
           return false;
         }
         return failureCount < 2;
@@ -1,16 +1,24 @@
 import { useQuery } from '@tanstack/react-query';
-import { fetchValuation } from '@/lib/api';
+import { fetchValuation, type ValuationOptions } from '@/lib/api';
 import { STALE_TIME } from '@/lib/constants';
+import { normalizeTicker } from '@/lib/utils';
 import type { ValuationDeepDive } from '@recon/shared';
 
-export function useValuation(ticker: string) {
+export function useValuation(ticker: string, options?: ValuationOptions) {
+  const hasOptions = Boolean(options?.horizonYears || options?.terminalGrowth !== undefined);
+
+  const normalizedTicker = normalizeTicker(ticker);
+
   return useQuery<ValuationDeepDive>({
-    queryKey: ['valuation', ticker.toUpperCase()],
-    queryFn: () => fetchValuation(ticker),
+    queryKey: hasOptions
+      ? ['valuation', normalizedTicker, options]
+      : ['valuation', normalizedTicker],
+    queryFn: () => fetchValuation(normalizedTicker, options),
     staleTime: STALE_TIME,
-    enabled: Boolean(ticker),
+    enabled: Boolean(normalizedTicker),
     retry: (failureCount, error) => {
-      if (error instanceof Error && 'status' in error && error.status === 404) {
+      // Out-of-bounds assumptions (400) won't succeed on retry either
+      if (error instanceof Error && 'status' in error && (error.status === 404 || error.status === 400)) {
         return false;
       }
       return failureCount < 2;
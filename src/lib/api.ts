@@ -1,4 +1,12 @@
-import type { StockDetailResponse, SearchResponse, ValuationDeepDive } from '@recon/shared';
+import type {
+  InsiderTrade,
+  StockDetailResponse,
+  StockSummaryResponse,
+  SearchResponse,
+  SearchResultType,
+  ValuationDeepDive,
+} from '@recon/shared';
+import { normalizeTicker } from '@/lib/utils';
 
 const API_BASE = process.env.NEXT_PUBLIC_API_URL || 'http://localhost:8080';
 
@@ -6,7 +14,8 @@ export class ApiError extends Error {
   constructor(
     public code: string,
     message: string,
-    public status?: number
+    public status?: number,
+    public details?: Record<string, string>
   ) {
     super(message);
     this.name = 'ApiError';
@@ -15,8 +24,9 @@ export class ApiError extends Error {
 
 const FETCH_TIMEOUT_MS = 15_000;
 
-async function fetchApi<T>(endpoint: string): Promise<T> {
-  const headers: HeadersInit = {};
+/** Send a request to the API and throw an ApiError for non-2xx responses. */
+async function requestApi(endpoint: string): Promise<Response> {
+  const headers: Record<string, string> = {};
 
   // Server-side requests don't include an Origin header, so authenticate with API key
   if (typeof window === 'undefined') {
@@ -41,26 +51,96 @@ async function fetchApi<T>(endpoint: string): Promise<T> {
     throw new ApiError(
       errorData.code || 'API_ERROR',
       errorData.message || 'An error occurred',
-      response.status
+      response.status,
+      errorData.details
     );
   }
 
+  return response;
+}
+
+async function fetchApi<T>(endpoint: string): Promise<T> {
+  const response = await requestApi(endpoint);
   return response.json();
 }
 
-export async function fetchStock(ticker: string): Promise<StockDetailResponse> {
-  return fetchApi<StockDetailResponse>(`/api/stock/${ticker.toUpperCase()}`);
+/** Normalize a ticker for a request path, rejecting input with no valid symbol characters. */
+function requireTicker(ticker: string): string {
+  const normalized = normalizeTicker(ticker);
+  if (!normalized) {
+    throw new ApiError('INVALID_TICKER', `Invalid ticker: "${ticker}"`);
+  }
+  return normalized;
+}
+
+export type StockDetailPeriodType = 'annual' | 'quarterly';
+
+export interface StockDetailOptions {
+  /** Statement cadence used for scores and growth (defaults to annual) */
+  period?: StockDetailPeriodType;
+  /** Point-in-time date (YYYY-MM-DD); only data filed/traded on or before it is used */
+  asOf?: string;
+  /** Number of financial statement periods to load (defaults to 2, capped by the API) */
+  financialPeriods?: number;
+  /** Days of price history to load (defaults to 365, capped by the API) */
+  priceDays?: number;
 }
 
-export async function searchTickers(query: string): Promise<SearchResponse> {
+export async function fetchStock(
+  ticker: string,
+  options?: StockDetailOptions
+): Promise<StockDetailResponse> {
+  const params = new URLSearchParams();
+  if (options?.period) params.set('period', options.period);
+  if (options?.asOf) params.set('asOf', options.asOf);
+  if (options?.financialPeriods) params.set('financialPeriods', options.financialPeriods.toString());
+  if (options?.priceDays) params.set('priceDays', options.priceDays.toString());
+  const query = params.toString();
+  return fetchApi<StockDetailResponse>(
+    `/api/stock/${requireTicker(ticker)}${query ? `?${query}` : ''}`
+  );
+}
+
+/** Trimmed view (company, quote, scores, top signals) for summary cards. */
+export async function fetchStockSummary(ticker: string): Promise<StockSummaryResponse> {
+  return fetchApi<StockSummaryResponse>(`/api/stock/${requireTicker(ticker)}?view=light`);
+}
+
+export interface SearchOptions {
+  /** Maximum number of results (capped by the API) */
+  limit?: number;
+  /** Restrict results to these asset types */
+  types?: SearchResultType[];
+}
+
+export async function searchTickers(query: string, options?: SearchOptions): Promise<SearchResponse> {
   if (!query || query.length < 1) {
     return { results: [], query: '' };
   }
-  return fetchApi<SearchResponse>(`/api/search?q=${encodeURIComponent(query)}`);
+  const params = new URLSearchParams({ q: query });
+  if (options?.limit) params.set('limit', options.limit.toString());
+  if (options?.types?.length) params.set('types', options.types.join(','));
+  return fetchApi<SearchResponse>(`/api/search?${params.toString()}`);
 }
 
-export async function fetchValuation(ticker: string): Promise<ValuationDeepDive> {
-  return fetchApi<ValuationDeepDive>(`/api/stock/${ticker.toUpperCase()}/valuation`);
+export interface ValuationOptions {
+  /** Owner-earnings DCF projection horizon in years (5-15) */
+  horizonYears?: number;
+  /** Owner-earnings DCF terminal growth rate as percentage (must be below the discount rate) */
+  terminalGrowth?: number;
+}
+
+export async function fetchValuation(
+  ticker: string,
+  options?: ValuationOptions
+): Promise<ValuationDeepDive> {
+  const params = new URLSearchParams();
+  if (options?.horizonYears) params.set('horizonYears', options.horizonYears.toString());
+  if (options?.terminalGrowth !== undefined) params.set('terminalGrowth', options.terminalGrowth.toString());
+  const query = params.toString();
+  return fetchApi<ValuationDeepDive>(
+    `/api/stock/${requireTicker(ticker)}/valuation${query ? `?${query}` : ''}`
+  );
 }
 
 // CruxAI Insight types
@@ -97,7 +177,7 @@ export async function fetchInsight(
   section: InsightSection
 ): Promise<InsightResponse> {
   return fetchApi<InsightResponse>(
-    `/api/v1/insights/${section}?ticker=${ticker.toUpperCase()}`
+    `/api/v1/insights/${section}?ticker=${requireTicker(ticker)}`
   );
 }
 
@@ -161,7 +241,29 @@ export interface InstitutionalDetail {
 }
 
 export async function fetchInstitutionalDetail(ticker: string): Promise<InstitutionalDetail> {
-  return fetchApi<InstitutionalDetail>(`/api/stock/${ticker.toUpperCase()}/institutional`);
+  return fetchApi<InstitutionalDetail>(`/api/stock/${requireTicker(ticker)}/institutional`);
+}
+
+// Insider Trades (paged)
+export interface InsiderTradesPage {
+  ticker: string;
+  trades: InsiderTrade[];
+  /** Cursor for the next page, null when there are no older trades */
+  nextCursor: string | null;
+}
+
+export async function fetchInsiderTrades(
+  ticker: string,
+  cursor?: string,
+  limit?: number
+): Promise<InsiderTradesPage> {
+  const params = new URLSearchParams();
+  if (cursor) params.set('cursor', cursor);
+  if (limit) params.set('limit', limit.toString());
+  const query = params.toString();
+  return fetchApi<InsiderTradesPage>(
+    `/api/stock/${requireTicker(ticker)}/insiders${query ? `?${query}` : ''}`
+  );
 }
 
 // =============================================================================
@@ -346,7 +448,7 @@ export async function fetchIncomeStatements(
   if (options?.limit) params.set('limit', options.limit.toString());
   const query = params.toString();
   return fetchApi<IncomeStatementResponse>(
-    `/api/stock/${ticker.toUpperCase()}/financials/income${query ? `?${query}` : ''}`
+    `/api/stock/${requireTicker(ticker)}/financials/income${query ? `?${query}` : ''}`
   );
 }
 
@@ -359,7 +461,7 @@ export async function fetchBalanceSheets(
   if (options?.limit) params.set('limit', options.limit.toString());
   const query = params.toString();
   return fetchApi<BalanceSheetResponse>(
-    `/api/stock/${ticker.toUpperCase()}/financials/balance-sheet${query ? `?${query}` : ''}`
+    `/api/stock/${requireTicker(ticker)}/financials/balance-sheet${query ? `?${query}` : ''}`
   );
 }
 
@@ -372,7 +474,7 @@ export async function fetchCashFlowStatements(
   if (options?.limit) params.set('limit', options.limit.toString());
   const query = params.toString();
   return fetchApi<CashFlowResponse>(
-    `/api/stock/${ticker.toUpperCase()}/financials/cash-flow${query ? `?${query}` : ''}`
+    `/api/stock/${requireTicker(ticker)}/financials/cash-flow${query ? `?${query}` : ''}`
   );
 }
 
@@ -418,6 +520,8 @@ export interface SectorStock {
   sma50: boolean | null;
   sma200: boolean | null;
   rsRank: number | null;
+  /** 1Y return minus the benchmark's (SPY) 1Y return, in percentage points */
+  relativeToBenchmark?: number | null;
   sparkline: number[];
   chartData1Y: number[];
 }
@@ -450,24 +554,93 @@ export interface SectorListResponse {
   sectors: string[];
 }
 
-export type SectorSortField = '52whigh' | 'ytd' | '1y' | 'marketcap' | 'ps' | 'pe';
+export type SectorSortField = '52whigh' | 'ytd' | '1y' | 'marketcap' | 'ps' | 'pe' | 'rsbench';
 
 export async function fetchSectors(): Promise<SectorListResponse> {
   return fetchApi<SectorListResponse>('/api/sectors');
 }
 
+function sectorOverviewEndpoint(sector: string, params: URLSearchParams): string {
+  const encodedSector = encodeURIComponent(sector.replace(/ /g, '-'));
+  return `/api/sectors/${encodedSector}/overview?${params.toString()}`;
+}
+
 export async function fetchSectorOverview(
   sector: string,
   sort: SectorSortField = '52whigh',
   limit: number = 20
 ): Promise<SectorOverviewResponse> {
-  const encodedSector = encodeURIComponent(sector.replace(/ /g, '-'));
   const params = new URLSearchParams();
   params.set('sort', sort);
   params.set('limit', limit.toString());
-  return fetchApi<SectorOverviewResponse>(
-    `/api/sectors/${encodedSector}/overview?${params.toString()}`
-  );
+  return fetchApi<SectorOverviewResponse>(sectorOverviewEndpoint(sector, params));
+}
+
+export interface CsvDownload {
+  blob: Blob;
+  /** Filename from the server's Content-Disposition header, if exposed */
+  filename: string | null;
+}
+
+/**
+ * Extract the filename from a Content-Disposition header, preferring the
+ * RFC 5987 `filename*=UTF-8''...` form over the plain `filename=` parameter.
+ */
+function contentDispositionFilename(header: string | null): string | null {
+  if (!header) return null;
+
+  const extended = header.match(/filename\*\s*=\s*[\w-]*'[^']*'([^;]+)/i);
+  if (extended) {
+    try {
+      return decodeURIComponent(extended[1].trim());
+    } catch {
+      // Malformed percent-encoding; fall back to the plain filename parameter
+    }
+  }
+
+  const plain = header.match(/filename\s*=\s*"?([^";]+)"?/i);
+  return plain ? plain[1].trim() : null;
+}
+
+/** Fetch the sector overview as a CSV file (empty cells for missing metrics). */
+export async function fetchSectorOverviewCsv(
+  sector: string,
+  sort: SectorSortField = '52whigh',
+  limit: number = 20
+): Promise<CsvDownload> {
+  const params = new URLSearchParams();
+  params.set('sort', sort);
+  params.set('limit', limit.toString());
+  params.set('format', 'csv');
+  const response = await requestApi(sectorOverviewEndpoint(sector, params));
+  return {
+    blob: await response.blob(),
+    filename: contentDispositionFilename(response.headers.get('Content-Disposition')),
+  };
+}
+
+// =============================================================================
+// Market Movers Types
+// =============================================================================
+
+export type MoverType = 'gainers' | 'losers' | 'active';
+
+export interface MarketMover {
+  ticker: string;
+  name: string;
+  price: number;
+  changePercent: number;
+}
+
+export interface MoversResponse {
+  type: MoverType;
+  /** Ranked by percent change (gainers/losers) or volume (active) */
+  movers: MarketMover[];
+  updatedAt: string;
+}
+
+export async function fetchMovers(type: MoverType): Promise<MoversResponse> {
+  return fetchApi<MoversResponse>(`/api/movers?type=${type}`);
 }
 
 // =============================================================================
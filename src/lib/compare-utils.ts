@@ -381,10 +381,7 @@ export const COMPARE_METRICS: Record<string, MetricConfig[]> = {
 const pathCache = new Map<string, string[]>();
 const MAX_CACHE_SIZE = 1000;
 
-/**
- * Safely access a nested property using dot notation.
- */
-export function getNestedValue(obj: unknown, path: string): number | null {
+function getPathParts(path: string): string[] {
   let parts = pathCache.get(path);
   if (!parts) {
     if (pathCache.size >= MAX_CACHE_SIZE) {
@@ -393,10 +390,23 @@ export function getNestedValue(obj: unknown, path: string): number | null {
     parts = path.split('.');
     pathCache.set(path, parts);
   }
+  return parts;
+}
+
+/**
+ * Safely access a nested property using dot notation.
+ * Returns null for metric values the API flagged as not meaningful.
+ */
+export function getNestedValue(obj: unknown, path: string): number | null {
+  const parts = getPathParts(path);
 
   let current: any = obj;
   for (const key of parts) {
     if (current && typeof current === 'object' && key in current) {
+      // Values the API flagged as not meaningful must not win comparisons
+      if (key === 'value' && current.meaningful === false) {
+        return null;
+      }
       current = current[key];
     } else {
       return null;
@@ -406,6 +416,23 @@ export function getNestedValue(obj: unknown, path: string): number | null {
   return typeof current === 'number' && !isNaN(current) ? current : null;
 }
 
+/**
+ * True when the metric at `path` is present but flagged not meaningful, so
+ * callers can show N/M rather than treating it as missing.
+ */
+export function isNestedValueNotMeaningful(obj: unknown, path: string): boolean {
+  const parts = getPathParts(path);
+  if (parts[parts.length - 1] !== 'value') return false;
+
+  let current: any = obj;
+  for (const key of parts.slice(0, -1)) {
+    if (!current || typeof current !== 'object' || !(key in current)) return false;
+    current = current[key];
+  }
+
+  return Boolean(current) && typeof current === 'object' && current.meaningful === false;
+}
+
 /**
  * Find the index of the winning value in an array.
  * Returns null if all values are null or invalid.
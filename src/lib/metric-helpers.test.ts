@@ -0,0 +1,60 @@
+import { describe, it, expect } from 'vitest';
+import { isNotMeaningful, marginTrendFor, meaningfulValue } from './metric-helpers';
+import type { MarginTrend, SectorMetric, ValuationMetric } from '@recon/shared';
+
+const sectorMetric = (overrides: Partial<SectorMetric>): SectorMetric => ({
+  value: 12,
+  sectorMin: -5,
+  sectorMedian: 8,
+  sectorMax: 20,
+  percentile: 60,
+  ...overrides,
+});
+
+describe('isNotMeaningful', () => {
+  it('is true only when meaningful is explicitly false', () => {
+    expect(isNotMeaningful(sectorMetric({ meaningful: false }))).toBe(true);
+    expect(isNotMeaningful(sectorMetric({ meaningful: true }))).toBe(false);
+    expect(isNotMeaningful(sectorMetric({}))).toBe(false);
+  });
+
+  it('is false for missing metrics and valuation metrics', () => {
+    const valuation: ValuationMetric = { value: 20, sectorMedian: 15 };
+    expect(isNotMeaningful(undefined)).toBe(false);
+    expect(isNotMeaningful(valuation)).toBe(false);
+  });
+});
+
+describe('meaningfulValue', () => {
+  it('returns the value for meaningful metrics', () => {
+    expect(meaningfulValue(sectorMetric({ value: 42 }))).toBe(42);
+  });
+
+  it('returns null for loss-to-profit EPS growth flagged not meaningful', () => {
+    expect(meaningfulValue(sectorMetric({ value: 900, meaningful: false }))).toBeNull();
+  });
+
+  it('returns null for missing metrics', () => {
+    expect(meaningfulValue(undefined)).toBeNull();
+    expect(meaningfulValue({ value: null, sectorMedian: null })).toBeNull();
+  });
+});
+
+describe('marginTrendFor', () => {
+  const trend: MarginTrend = {
+    grossMarginDeltaBps: 150,
+    grossMarginDirection: 'expanding',
+    operatingMarginDeltaBps: -80,
+    operatingMarginDirection: 'contracting',
+  };
+
+  it('picks the requested margin', () => {
+    expect(marginTrendFor(trend, 'gross')).toEqual({ direction: 'expanding', deltaBps: 150 });
+    expect(marginTrendFor(trend, 'operating')).toEqual({ direction: 'contracting', deltaBps: -80 });
+  });
+
+  it('is undefined for single-period data', () => {
+    expect(marginTrendFor(null, 'gross')).toBeUndefined();
+    expect(marginTrendFor(undefined, 'operating')).toBeUndefined();
+  });
+});
@@ -3,8 +3,8 @@
  * Consolidates duplicated metric conversion and formatting logic.
  */
 
-import type { Metric } from '@/components/dashboard/sections/metric-row';
-import type { ValuationMetric, SectorMetric } from '@recon/shared';
+import type { Metric, MetricTrend } from '@/components/dashboard/sections/metric-row';
+import type { MarginTrend, ValuationMetric, SectorMetric } from '@recon/shared';
 
 /**
  * Options for converting a metric to the standardized Metric format.
@@ -14,6 +14,7 @@ export interface ToMetricOptions {
   higherIsBetter: boolean;
   info?: string;
   learnMoreUrl?: string;
+  trend?: MetricTrend;
 }
 
 /**
@@ -22,6 +23,39 @@ export interface ToMetricOptions {
  */
 type MetricSource = ValuationMetric | SectorMetric | undefined;
 
+/** Display text for values flagged as not meaningful. */
+export const NOT_MEANINGFUL_DISPLAY = 'N/M';
+
+/**
+ * True when the API flagged the value as not meaningful (e.g., EPS growth
+ * off a negative prior year). Such values should render as N/M and never
+ * be compared or shared.
+ */
+export function isNotMeaningful(source: MetricSource): boolean {
+  return source != null && 'meaningful' in source && source.meaningful === false;
+}
+
+/**
+ * The metric value, or null when missing or not meaningful.
+ */
+export function meaningfulValue(source: MetricSource): number | null {
+  return isNotMeaningful(source) ? null : source?.value ?? null;
+}
+
+/**
+ * Pick the gross or operating margin trend for a metric row. Undefined when
+ * the API had only one period to compare.
+ */
+export function marginTrendFor(
+  trend: MarginTrend | null | undefined,
+  margin: 'gross' | 'operating'
+): MetricTrend | undefined {
+  if (!trend) return undefined;
+  return margin === 'gross'
+    ? { direction: trend.grossMarginDirection, deltaBps: trend.grossMarginDeltaBps }
+    : { direction: trend.operatingMarginDirection, deltaBps: trend.operatingMarginDeltaBps };
+}
+
 /**
  * Convert a ValuationMetric or SectorMetric to the standardized Metric format.
  * This is a shared helper used by all metric section components.
@@ -32,6 +66,8 @@ export function toMetric(
   source: MetricSource,
   options: ToMetricOptions
 ): Metric {
+  const notMeaningful = isNotMeaningful(source);
+
   return {
     key,
     label,
@@ -42,6 +78,8 @@ export function toMetric(
     higherIsBetter: options.higherIsBetter,
     info: options.info,
     learnMoreUrl: options.learnMoreUrl,
+    notMeaningful,
+    trend: options.trend,
   };
 }
 
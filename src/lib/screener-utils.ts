@@ -1,4 +1,5 @@
 import type { ScreenerFilters, ScreenerStock } from '@/lib/api';
+import { downloadBlob } from '@/lib/utils';
 
 // =============================================================================
 // Constants
@@ -380,11 +381,5 @@ export function convertToCSV(stocks: ScreenerStock[]): string {
 /** Trigger a CSV file download in the browser. */
 export function exportScreenerCSV(stocks: ScreenerStock[], filename = 'screener-results.csv') {
   const csv = convertToCSV(stocks);
-  const blob = new Blob([csv], { type: 'text/csv;charset=utf-8;' });
-  const url = URL.createObjectURL(blob);
-  const link = document.createElement('a');
-  link.href = url;
-  link.download = filename;
-  link.click();
-  URL.revokeObjectURL(url);
+  downloadBlob(new Blob([csv], { type: 'text/csv;charset=utf-8;' }), filename);
 }
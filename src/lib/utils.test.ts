@@ -1,5 +1,5 @@
 import { describe, it, expect } from 'vitest';
-import { formatDate } from './utils';
+import { formatDate, normalizeTicker } from './utils';
 
 describe('formatDate', () => {
   it('formats a date string correctly', () => {
@@ -21,3 +21,29 @@ describe('formatDate', () => {
     expect(() => formatDate('invalid-date')).toThrow();
   });
 });
+
+describe('normalizeTicker', () => {
+  it.each([
+    ['AAPL', 'AAPL'],
+    ['aapl', 'AAPL'],
+    ['BRK.B', 'BRK.B'],
+    ['brk.b', 'BRK.B'],
+    ['BRK-B', 'BRK.B'],
+    ['BRK/B', 'BRK.B'],
+    ['BF.B', 'BF.B'],
+    ['BF-B', 'BF.B'],
+    ['RDS.A', 'RDS.A'],
+    ['rds/a', 'RDS.A'],
+    [' aapl ', 'AAPL'],
+    ['\tmsft\n', 'MSFT'],
+    [' brk-b ', 'BRK.B'],
+    ['AAPL!', 'AAPL'],
+    ['$TSLA', 'TSLA'],
+  ])('normalizes %s to %s', (input, expected) => {
+    expect(normalizeTicker(input)).toBe(expected);
+  });
+
+  it.each(['', '   ', '$', '^', '!!'])('normalizes %j to an empty string', (input) => {
+    expect(normalizeTicker(input)).toBe('');
+  });
+});
@@ -71,6 +71,23 @@ export function formatDate(date: string | Date): string {
   return DATE_FORMATTER.format(new Date(date));
 }
 
+const CLASS_SEPARATOR = /[/-]/g;
+const INVALID_TICKER_CHARS = /[^A-Z0-9.]/g;
+
+/**
+ * Canonical ticker form sent to the API: trimmed, uppercase, share-class
+ * separators as dots ("brk/b", "BRK-B" -> "BRK.B") and anything else that
+ * can't appear in a ticker stripped. The API maps this to each provider's
+ * own convention.
+ */
+export function normalizeTicker(ticker: string): string {
+  return ticker
+    .trim()
+    .toUpperCase()
+    .replace(CLASS_SEPARATOR, '.')
+    .replace(INVALID_TICKER_CHARS, '');
+}
+
 export function debounce<T extends (...args: any[]) => void>(
   func: T,
   wait: number
@@ -95,3 +112,13 @@ export function debounce<T extends (...args: any[]) => void>(
 
   return debounced as T & { cancel: () => void };
 }
+
+/** Trigger a browser download of the blob under the given filename. */
+export function downloadBlob(blob: Blob, filename: string): void {
+  const url = URL.createObjectURL(blob);
+  const link = document.createElement('a');
+  link.href = url;
+  link.download = filename;
+  link.click();
+  URL.revokeObjectURL(url);
+}